@@ -0,0 +1,101 @@
+package sdbidi
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// A Batch resolves and orders many independent paragraphs concurrently on a
+// bounded number of goroutines. Each goroutine processes its share of the
+// inputs with a Paragraph of its own, which the Batch keeps for the next call
+// of Order, so that the memory of the Paragraphs is reused.
+//
+// The zero value is ready to use. The methods of a Batch should only be called
+// by one goroutine at a time.
+type Batch struct {
+	// Workers is the maximum number of goroutines used by Order. If it is
+	// zero or negative, runtime.GOMAXPROCS(0) is used.
+	Workers int
+
+	paragraphs []*Paragraph
+}
+
+// A BatchResult holds the result for one input of Batch.Order. It does not
+// share memory with the Batch or other results, so it may be used by another
+// goroutine while the Batch processes the next inputs.
+type BatchResult struct {
+	// Ordering is the visual ordering of the runs of the paragraph.
+	Ordering Ordering
+
+	// Levels holds the resolved embedding level of each character of the
+	// paragraph, as returned by Paragraph.Levels.
+	Levels []Level
+
+	// N is the number of bytes of the input that make up the paragraph,
+	// including its separator, as returned by Paragraph.SetBytes.
+	N int
+
+	// Err is the error returned by SetBytes or Order for the input, for
+	// example ErrEmptyParagraph. Ordering and Levels are empty if it is not
+	// nil.
+	Err error
+}
+
+// Order resolves the first paragraph of each of the inputs with the given
+// options and returns the results in the order of the inputs. Like SetBytes,
+// it ignores the text after the first paragraph separator of an input; use
+// BatchResult.N to split a text into paragraphs first.
+//
+// The inputs are processed concurrently, so the function passed to the
+// LevelFunc option must be safe for concurrent use. Order does not keep a
+// reference to the inputs.
+func (b *Batch) Order(inputs [][]byte, opts ...Option) []BatchResult {
+	results := make([]BatchResult, len(inputs))
+	workers := b.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+	for len(b.paragraphs) < workers {
+		b.paragraphs = append(b.paragraphs, &Paragraph{})
+	}
+
+	// Each worker takes the next input that has not been taken yet.
+	var next int64 = -1
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for _, p := range b.paragraphs[:workers] {
+		go func(p *Paragraph) {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(inputs) {
+					return
+				}
+				results[i] = p.orderResult(inputs[i], opts)
+			}
+		}(p)
+	}
+	wg.Wait()
+	return results
+}
+
+// orderResult resolves the first paragraph of b and returns its result
+// without sharing memory with p.
+func (p *Paragraph) orderResult(b []byte, opts []Option) BatchResult {
+	var r BatchResult
+	if r.N, r.Err = p.SetBytes(b, opts...); r.Err != nil {
+		return r
+	}
+	o, err := p.Order()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.Ordering = o.Clone()
+	r.Levels = append([]Level(nil), p.levels...)
+	return r
+}
@@ -2,25 +2,40 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:generate go run gen.go gen_trieval.go gen_ranges.go
+//go:generate go run gen.go gen_trieval.go gen_ranges.go -unicode 12.0.0 -tags "sdbidi_unicode12 && !sdbidi_unicode15"
+//go:generate go run gen.go gen_trieval.go gen_ranges.go -unicode 15.0.0 -tags "sdbidi_unicode15 || !sdbidi_unicode12"
 
 // Package bidi contains functionality for bidirectional text support.
 //
 // See https://www.unicode.org/reports/tr9.
 //
+// The package contains the character tables of several Unicode versions. Each
+// version has a build tag, sdbidi_unicode12 for Unicode 12.0.0 and
+// sdbidi_unicode15 for Unicode 15.0.0. Without a tag the tables of the most
+// recent version are used, which changes when tables of a new version are
+// added; build with a tag to keep the output for a given text stable across
+// updates of the package. If several tags are given, the most recent of their
+// versions is used. UnicodeVersion reports the version in use.
+//
 // NOTE: UNDER CONSTRUCTION. This API may change in backwards incompatible ways
 // and without notice.
 package sdbidi
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
+	"io"
+	"sort"
+	"unicode/utf8"
 )
 
-// This API tries to avoid dealing with embedding levels for now. Under the hood
-// these will be computed, but the question is to which extent the user should
-// know they exist. We should at some point allow the user to specify an
-// embedding hierarchy, though.
+// Most users of this API only need the Direction of a paragraph or run. The
+// embedding levels computed under the hood are available through
+// Paragraph.Levels and Run.Level for callers that need the nesting depth, for
+// example to do their own reordering or to pass them on to a shaper. We should
+// at some point allow the user to specify an embedding hierarchy, though.
 
 // A Direction indicates the overall flow of text.
 type Direction int
@@ -46,208 +61,793 @@ const (
 )
 
 type options struct {
-	defaultDirection Direction
+	// level is the paragraph embedding level set by ForceDirection or
+	// implicitLevel if it is determined from the text.
+	level Level
+
+	// defaultLevel is the paragraph embedding level used if the text has no
+	// strong characters. Document changes it for rule HL1.
+	defaultLevel Level
+
+	inheritDirection bool
+
+	// levelFunc supplies explicit embedding levels if not nil.
+	levelFunc func(p int) Level
+
+	// maxLength, maxBracketPairs and workBudget hold the limits set by the
+	// options of the same name. They are negative if there is no limit.
+	maxLength       int
+	maxBracketPairs int
+	workBudget      int
+
+	// cache is set by UseCache.
+	cache *Cache
+
+	// err records an invalid option.
+	err error
+}
+
+// newOptions returns the options resulting from applying opts to the
+// defaults.
+func newOptions(opts []Option) options {
+	var o options
+	o.set(opts)
+	return o
+}
+
+// set sets o to the options resulting from applying opts to the defaults.
+func (o *options) set(opts []Option) {
+	*o = options{level: implicitLevel, maxLength: -1, maxBracketPairs: -1, workBudget: -1}
+	for _, fn := range opts {
+		fn(o)
+	}
+}
+
+// baseLevel returns the paragraph embedding level used for a text without
+// strong characters.
+func (o *options) baseLevel() Level {
+	if o.level != implicitLevel {
+		return o.level
+	}
+	return o.defaultLevel
+}
+
+// levelForDirection returns the paragraph embedding level for d.
+func levelForDirection(d Direction) (Level, error) {
+	switch d {
+	case LeftToRight:
+		return 0, nil
+	case RightToLeft:
+		return 1, nil
+	}
+	return 0, fmt.Errorf("%w: %d", ErrInvalidDirection, d)
 }
 
 // An Option is an option for Bidi processing.
 type Option func(*options)
 
-// ICU allows the user to define embedding levels. This may be used, for example,
-// to use hierarchical structure of markup languages to define embeddings.
-// The following option may be a way to expose this functionality in this API.
-// // LevelFunc sets a function that associates nesting levels with the given text.
-// // The levels function will be called with monotonically increasing values for p.
-// func LevelFunc(levels func(p int) int) Option {
-// 	panic("unimplemented")
-// }
+// LevelFunc sets a function that associates explicit embedding levels with the
+// given text. This may be used, for example, to use the hierarchical structure
+// of markup languages to define embeddings instead of inserting explicit
+// formatting characters into the text. The levels function will be called with
+// monotonically increasing rune positions p of the paragraph text.
+//
+// Like the embedding levels input of ICU, the levels replace rules X1-X8:
+// explicit directional formatting characters in the text are ignored. Levels
+// below the paragraph embedding level are raised to it; levels above 125 cause
+// Order to return an error.
+func LevelFunc(levels func(p int) Level) Option {
+	return func(opts *options) {
+		opts.levelFunc = levels
+	}
+}
 
 // DefaultDirection sets the default direction for a Paragraph. The direction is
-// overridden if the text contains directional characters.
+// overridden if the text contains directional characters (rules P2 and P3).
+// The direction must be LeftToRight or RightToLeft.
 func DefaultDirection(d Direction) Option {
 	return func(opts *options) {
-		opts.defaultDirection = d
+		lvl, err := levelForDirection(d)
+		if err != nil && opts.err == nil {
+			opts.err = err
+		}
+		opts.defaultLevel = lvl
+	}
+}
+
+// ForceDirection sets the direction of a Paragraph regardless of the
+// directional characters in the text. The direction must be LeftToRight or
+// RightToLeft.
+func ForceDirection(d Direction) Option {
+	return func(opts *options) {
+		lvl, err := levelForDirection(d)
+		if err != nil {
+			if opts.err == nil {
+				opts.err = err
+			}
+			return
+		}
+		opts.level = lvl
+	}
+}
+
+// InheritDirection causes paragraphs of a Document without strong characters to
+// take the direction of the preceding paragraph instead of the default
+// direction (rule HL1). It has no effect on a single Paragraph.
+func InheritDirection() Option {
+	return func(opts *options) {
+		opts.inheritDirection = true
+	}
+}
+
+// MaxLength limits the length of a paragraph to n runes, not counting its
+// paragraph separator. For a longer paragraph, SetBytes and SetString stop
+// reading the text once the limit is exceeded and return 0 and a *LimitError;
+// the Paragraph holds no text. A negative n means no limit, which is the
+// default.
+//
+// The limits set by MaxLength, MaxBracketPairs and WorkBudget bound the
+// memory and time needed for processing text from untrusted sources.
+func MaxLength(n int) Option {
+	return func(opts *options) {
+		opts.maxLength = n
+	}
+}
+
+// MaxBracketPairs limits the number of bracket pairs in a paragraph that are
+// resolved by rule N0 to n. Once the limit is reached, the remaining brackets
+// of the paragraph are not paired and are resolved like other neutral
+// characters, as are all brackets of an isolating run sequence in which the
+// brackets are nested more than 63 deep (definition BD16). The levels may then
+// differ from the ones required by the algorithm. A negative n means no limit,
+// which is the default.
+func MaxBracketPairs(n int) Option {
+	return func(opts *options) {
+		opts.maxBracketPairs = n
+	}
+}
+
+// WorkBudget limits the work done for resolving a paragraph to about n steps.
+// Each phase of the algorithm takes one step per character, and rule N0 takes
+// one step per character it inspects for a bracket pair; a paragraph typically
+// takes less than ten steps per character. If the budget is exhausted, the
+// methods that resolve the paragraph, like Order and Levels, return a
+// *LimitError. Paragraphs without right-to-left characters and explicit
+// formatting characters are resolved without running the algorithm and take
+// no steps. A negative n means no limit, which is the default.
+func WorkBudget(n int) Option {
+	return func(opts *options) {
+		opts.workBudget = n
 	}
 }
 
 // A Paragraph holds a single Paragraph for Bidi processing.
+//
+// A Paragraph reuses its memory for each text passed to SetBytes or
+// SetString, so that processing many paragraphs with the same Paragraph does
+// not allocate once the buffers have grown to the size of the largest
+// paragraph.
 type Paragraph struct {
-	p          []byte
 	o          Ordering
-	opts       []Option
 	types      []Class
 	pairTypes  []bracketType
 	pairValues []rune
 	runes      []rune
 	options    options
+
+	// offsets holds the byte offset of each rune in the paragraph text
+	// followed by the offset of the end of the paragraph text.
+	offsets []int
+
+	// explicitLevels holds the levels returned by the LevelFunc option.
+	explicitLevels []Level
+
+	// classes is the set of the classes of the runes, with bit 1<<c set for
+	// class c.
+	classes uint32
+
+	// para, levels and embeddingLevel hold the result of the bidi algorithm.
+	// They are only valid if resolved is set. If fastPath is set, the text
+	// has been resolved without running the algorithm and para is not used.
+	para           *paragraph
+	levels         []Level
+	embeddingLevel Level
+	resolved       bool
+	fastPath       bool
+
+	// edited is set once the text has been edited by Replace, Insert or
+	// Delete. The results of the algorithm are then kept for reuse after
+	// the next edit.
+	edited bool
+
+	// cacheMiss is set if the text was not found in the cache of the
+	// options. cacheText then holds the text without its paragraph
+	// separator, so that the results can be added to the cache once the
+	// text is resolved.
+	cacheMiss bool
+	cacheText string
 }
 
-func (p *Paragraph) prepareInput() (n int, err error) {
-	p.runes = bytes.Runes(p.p)
-	bytecount := 0
-	// clear slices from previous SetString or SetBytes
-	p.pairTypes = nil
-	p.pairValues = nil
-	p.types = nil
-
-	for _, r := range p.runes {
-		props, i := LookupRune(r)
-		bytecount += i
-		cls := props.Class()
-		if cls == B {
-			return bytecount, nil
+// rtlClasses is the set of classes that rule out the fast path for
+// left-to-right text: right-to-left letters, Arabic numbers and explicit
+// formatting characters.
+const rtlClasses = 1<<R | 1<<AL | 1<<AN |
+	1<<LRO | 1<<RLO | 1<<LRE | 1<<RLE | 1<<PDF | 1<<LRI | 1<<RLI | 1<<FSI | 1<<PDI
+
+// decodeRune decodes the rune at offset n of b or, if b is nil, of s.
+func decodeRune(b []byte, s string, n int) (r rune, size int) {
+	if b != nil {
+		return utf8.DecodeRune(b[n:])
+	}
+	return utf8.DecodeRuneInString(s[n:])
+}
+
+// prepareInput computes the properties of the runes of the first paragraph of
+// the text, which is given as b or, if b is nil, as s. It returns the number
+// of bytes of the paragraph including its separator. It returns a *LimitError
+// as soon as the paragraph exceeds the maximum length set by the options.
+func (p *Paragraph) prepareInput(b []byte, s string) (n int, err error) {
+	length := len(s)
+	if b != nil {
+		length = len(b)
+	}
+	for n < length {
+		r, size := decodeRune(b, s, n)
+		props, _ := LookupRune(r)
+		if props.Class() == B {
+			p.offsets = append(p.offsets, n)
+			if r == '\r' && n+1 < length {
+				if next, _ := decodeRune(b, s, n+1); next == '\n' {
+					// CR LF is a single paragraph separator.
+					size++
+				}
+			}
+			return n + size, nil
 		}
-		p.types = append(p.types, cls)
-		if props.IsOpeningBracket() {
-			p.pairTypes = append(p.pairTypes, bpOpen)
-			p.pairValues = append(p.pairValues, r)
-		} else if props.IsBracket() {
-			// this must be a closing bracket,
-			// since IsOpeningBracket is not true
-			p.pairTypes = append(p.pairTypes, bpClose)
-			p.pairValues = append(p.pairValues, r)
-		} else {
-			p.pairTypes = append(p.pairTypes, bpNone)
-			p.pairValues = append(p.pairValues, 0)
+		if max := p.options.maxLength; max >= 0 && len(p.runes) == max {
+			return n, &LimitError{Limit: "MaxLength", Max: max}
 		}
+		p.appendRune(r, props, n)
+		n += size
+	}
+	p.offsets = append(p.offsets, n)
+	return n, nil
+}
+
+// appendRune adds the rune r with the given properties at byte offset n to
+// the text of p.
+func (p *Paragraph) appendRune(r rune, props Properties, n int) {
+	cls := props.Class()
+	p.runes = append(p.runes, r)
+	p.offsets = append(p.offsets, n)
+	p.types = append(p.types, cls)
+	p.classes |= 1 << cls
+	if props.IsOpeningBracket() {
+		p.pairTypes = append(p.pairTypes, bpOpen)
+		p.pairValues = append(p.pairValues, canonicalBracket(r))
+	} else if props.IsBracket() {
+		// this must be a closing bracket,
+		// since IsOpeningBracket is not true
+		p.pairTypes = append(p.pairTypes, bpClose)
+		p.pairValues = append(p.pairValues, canonicalBracket(props.reverseBracket(r)))
+	} else {
+		p.pairTypes = append(p.pairTypes, bpNone)
+		p.pairValues = append(p.pairValues, 0)
+	}
+}
+
+// Reset discards the text of p and everything computed for it, but keeps the
+// allocated memory for the next call of SetBytes or SetString. Orderings and
+// levels obtained from p become invalid.
+func (p *Paragraph) Reset() {
+	p.runes = p.runes[:0]
+	p.offsets = p.offsets[:0]
+	p.types = p.types[:0]
+	p.pairTypes = p.pairTypes[:0]
+	p.pairValues = p.pairValues[:0]
+	p.classes = 0
+	p.options.set(nil)
+	p.levels = p.levels[:0]
+	p.embeddingLevel = 0
+	p.resolved = false
+	p.o.clear()
+	p.edited = false
+	if p.para != nil {
+		p.para.prev.valid = false
 	}
-	return bytecount, nil
+	p.cacheMiss, p.cacheText = false, ""
 }
 
 // SetBytes configures p for the given paragraph text. It replaces text
-// previously set by SetBytes or SetString. If b contains a paragraph separator
-// it will only process the first paragraph and report the number of bytes
-// consumed from b including this separator. Error may be non-nil if options are
+// previously set by SetBytes or SetString, reusing the memory of p, so that
+// Orderings and levels obtained for the previous text become invalid. If b
+// contains a paragraph separator it will only process the first paragraph and
+// report the number of bytes consumed from b including this separator. A CR LF
+// sequence counts as a single separator. Error may be non-nil if options are
 // given.
+//
+// The Paragraph does not keep a reference to b.
 func (p *Paragraph) SetBytes(b []byte, opts ...Option) (n int, err error) {
-	p.p = b
-	p.opts = opts
-	return p.prepareInput()
+	if b == nil {
+		b = []byte{}
+	}
+	return p.set(b, "", opts)
 }
 
 // SetString configures p for the given paragraph text. It replaces text
-// previously set by SetBytes or SetString. If s contains a paragraph separator
-// it will only process the first paragraph and report the number of bytes
-// consumed from s including this separator. Error may be non-nil if options are
-// given.
+// previously set by SetBytes or SetString, reusing the memory of p, so that
+// Orderings and levels obtained for the previous text become invalid. If s
+// contains a paragraph separator it will only process the first paragraph and
+// report the number of bytes consumed from s including this separator. Error
+// may be non-nil if options are given.
 func (p *Paragraph) SetString(s string, opts ...Option) (n int, err error) {
-	p.p = []byte(s)
-	p.opts = opts
-	return p.prepareInput()
+	return p.set(nil, s, opts)
+}
+
+// set implements SetBytes and SetString for a text given as b or, if b is nil,
+// as s.
+func (p *Paragraph) set(b []byte, s string, opts []Option) (n int, err error) {
+	p.Reset()
+	p.options.set(opts)
+	if c := p.options.cache; c != nil && p.options.levelFunc == nil {
+		end, size := paragraphEnd(b, s)
+		var e *cacheEntry
+		var enabled bool
+		if b != nil {
+			e, enabled = c.lookup(p.options.cacheOptions(), b[:end], "")
+		} else {
+			e, enabled = c.lookup(p.options.cacheOptions(), nil, s[:end])
+		}
+		if e != nil {
+			p.loadCached(e)
+			return size, p.options.err
+		}
+		if enabled {
+			p.cacheMiss = true
+			if b != nil {
+				p.cacheText = string(b[:end])
+			} else {
+				// The text becomes a key of the cache, which must not
+				// keep the rest of s alive.
+				p.cacheText = cloneString(s[:end])
+			}
+		}
+	}
+	if n, err = p.prepareInput(b, s); err != nil {
+		p.Reset()
+		return 0, err
+	}
+	return n, p.options.err
 }
 
 // IsLeftToRight reports whether the principle direction of rendering for this
 // paragraphs is left-to-right. If this returns false, the principle direction
 // of rendering is right-to-left.
 func (p *Paragraph) IsLeftToRight() bool {
-	return p.Direction() == LeftToRight
+	return p.embeddingLevel&1 == 0
 }
 
-// Direction returns the direction of the text of this paragraph.
+// Direction returns the direction of the text of this paragraph. It resolves
+// the levels of the paragraph if this has not been done yet.
 //
-// The direction may be LeftToRight, RightToLeft, Mixed, or Neutral.
+// The direction may be LeftToRight, RightToLeft, Mixed, or Neutral. It is
+// Neutral if the paragraph cannot be resolved, for example because it is
+// empty.
 func (p *Paragraph) Direction() Direction {
-	return p.o.Direction()
+	if err := p.resolve(); err != nil {
+		return Neutral
+	}
+	return directionForLevels(p.levels)
 }
 
-// TODO: what happens if the position is > len(input)? This should return an error.
-
-// RunAt reports the Run at the given position of the input text.
+// RunAt reports the Run at the given position of the input text. It returns
+// the zero Run if the paragraph has not been ordered or if pos is not the
+// position of a character of the text.
 //
 // This method can be used for computing line breaks on paragraphs.
 func (p *Paragraph) RunAt(pos int) Run {
-	c := 0
-	runNumber := 0
-	for i, r := range p.o.runes {
-		c += len(r)
-		if pos < c {
-			runNumber = i
-		}
+	if p.o.NumRuns() == 0 {
+		return Run{}
+	}
+	runNumber := p.o.runAt(pos)
+	if runNumber < 0 {
+		return Run{}
 	}
 	return p.o.Run(runNumber)
 }
 
-func calculateOrdering(levels []level, runes []rune) Ordering {
-	var curDir Direction
+// RunAtByte is like RunAt, but takes the byte offset of a character in the
+// text passed to SetBytes or SetString. It returns the zero Run for an offset
+// outside of the text.
+func (p *Paragraph) RunAtByte(offset int) Run {
+	return p.RunAt(p.runeIndex(offset))
+}
 
-	prevDir := Neutral
-	prevI := 0
+// runeIndex returns the position of the rune that contains the byte at the
+// given offset. For the offset of the end of the text it returns the number of
+// runes.
+func (p *Paragraph) runeIndex(offset int) int {
+	return sort.SearchInts(p.offsets, offset+1) - 1
+}
 
-	o := Ordering{}
-	// lvl = 0,2,4,...: left to right
-	// lvl = 1,3,5,...: right to left
+// calculate splits runes into runs of equal embedding level and stores them
+// in o in visual order, reusing the memory of o. Rule L2 is applied to the
+// runs rather than to the individual characters: the characters of a run stay
+// in logical order and have to be reversed by the renderer if the run is
+// right-to-left. The runes start at position pos of the paragraph.
+func (o *Ordering) calculate(levels []Level, runes []rune, offsets []int, pos int) {
+	o.clear()
+	o.text = runes
+	o.offsets = offsets
+	o.start = pos
+	o.direction = directionForLevels(levels)
 	for i, lvl := range levels {
-		if lvl%2 == 0 {
-			curDir = LeftToRight
-		} else {
-			curDir = RightToLeft
+		if i == 0 || lvl != levels[i-1] {
+			o.logicalLevels = append(o.logicalLevels, lvl)
+			o.logical = append(o.logical, pos+i)
+		}
+	}
+	o.reorderRuns()
+}
+
+// reorderRuns applies rule L2 to the runs in logical order given by
+// o.logical and o.logicalLevels and adds them to o in visual order.
+func (o *Ordering) reorderRuns() {
+	o.visual = resizeInts(o.visual, len(o.logical))
+	for v, l := range o.l2.reorder(o.logicalLevels) {
+		start := o.logical[l]
+		end := o.start + len(o.text)
+		if l+1 < len(o.logical) {
+			end = o.logical[l+1]
 		}
-		if curDir != prevDir {
-			if i > 0 {
-				o.runes = append(o.runes, runes[prevI:i])
-				o.directions = append(o.directions, prevDir)
-				o.startpos = append(o.startpos, prevI)
+		o.runes = append(o.runes, o.text[start-o.start:end-o.start])
+		o.levels = append(o.levels, o.logicalLevels[l])
+		o.startpos = append(o.startpos, start)
+		o.visual[l] = v
+	}
+}
+
+// runAt returns the visual index of the run that contains the character at
+// the given position, or -1 if there is none.
+func (o *Ordering) runAt(pos int) int {
+	l := sort.SearchInts(o.logical, pos+1) - 1
+	if l < 0 || pos >= o.start+len(o.text) {
+		return -1
+	}
+	return o.visual[l]
+}
+
+// clear removes all runs from o, but keeps the allocated memory.
+func (o *Ordering) clear() {
+	o.runes = o.runes[:0]
+	o.levels = o.levels[:0]
+	o.startpos = o.startpos[:0]
+	o.logical = o.logical[:0]
+	o.logicalLevels = o.logicalLevels[:0]
+	o.visual = o.visual[:0]
+	o.text = nil
+	o.offsets = nil
+	o.start = 0
+	o.direction = LeftToRight
+}
+
+// Clone returns a copy of o that does not share memory with o or the
+// Paragraph it was obtained from. Unlike o, the copy stays valid when the
+// Paragraph changes and may be used by several goroutines at once.
+func (o *Ordering) Clone() Ordering {
+	c := Ordering{
+		runes:         make([][]rune, len(o.runes)),
+		levels:        append([]Level(nil), o.levels...),
+		startpos:      append([]int(nil), o.startpos...),
+		direction:     o.direction,
+		text:          append([]rune(nil), o.text...),
+		offsets:       append([]int(nil), o.offsets...),
+		start:         o.start,
+		logical:       append([]int(nil), o.logical...),
+		logicalLevels: append([]Level(nil), o.logicalLevels...),
+		visual:        append([]int(nil), o.visual...),
+	}
+	for i, run := range o.runes {
+		start := o.startpos[i] - o.start
+		c.runes[i] = c.text[start : start+len(run)]
+	}
+	return c
+}
+
+// Equal reports whether o and other have the same runs in the same visual
+// order: the same text, levels and rune and byte positions. Orderings of
+// different paragraphs or lines may be equal.
+func (o *Ordering) Equal(other *Ordering) bool {
+	if o.direction != other.direction || len(o.runes) != len(other.runes) {
+		return false
+	}
+	for i := range o.runes {
+		r, s := o.Run(i), other.Run(i)
+		if r.level != s.level || r.startpos != s.startpos || r.bytestart != s.bytestart || r.byteend != s.byteend || len(r.runes) != len(s.runes) {
+			return false
+		}
+		for j, c := range r.runes {
+			if s.runes[j] != c {
+				return false
 			}
-			prevI = i
-			prevDir = curDir
 		}
 	}
-	o.runes = append(o.runes, runes[prevI:])
-	o.directions = append(o.directions, prevDir)
-	o.startpos = append(o.startpos, prevI)
-	return o
+	return true
 }
 
-// Order computes the visual ordering of all the runs in a Paragraph.
-func (p *Paragraph) Order() (Ordering, error) {
+// Hash returns a hash of o. Equal Orderings have the same hash.
+func (o *Ordering) Hash() uint64 {
+	h := fnv.New64a()
+	var buf [binary.MaxVarintLen64]byte
+	write := func(v int64) {
+		h.Write(buf[:binary.PutVarint(buf[:], v)])
+	}
+	write(int64(o.direction))
+	write(int64(len(o.runes)))
+	for i := range o.runes {
+		r := o.Run(i)
+		write(int64(r.level))
+		write(int64(r.startpos))
+		write(int64(r.bytestart))
+		write(int64(r.byteend))
+		write(int64(len(r.runes)))
+		for _, c := range r.runes {
+			write(int64(c))
+		}
+	}
+	return h.Sum64()
+}
+
+// directionForLevel reports the direction of text at the given embedding
+// level.
+func directionForLevel(lvl Level) Direction {
+	if lvl&1 == 0 {
+		return LeftToRight
+	}
+	return RightToLeft
+}
+
+// directionForLevels reports the direction of text with the given embedding
+// levels, which must not be empty.
+func directionForLevels(levels []Level) Direction {
+	d := directionForLevel(levels[0])
+	for _, lvl := range levels[1:] {
+		if directionForLevel(lvl) != d {
+			return Mixed
+		}
+	}
+	return d
+}
+
+// resolve runs the bidi algorithm on the paragraph text unless this has been
+// done since the last call to SetBytes or SetString.
+func (p *Paragraph) resolve() error {
+	if p.resolved {
+		return nil
+	}
 	if len(p.types) == 0 {
-		return Ordering{}, fmt.Errorf("Cannot order empty paragraph")
+		return ErrEmptyParagraph
+	}
+	if p.fastPath = p.leftToRightOnly(); p.fastPath {
+		p.levels = resizeLevels(p.levels, len(p.types))
+		setLevels(p.levels, 0)
+		p.embeddingLevel = 0
+		p.resolved = true
+		if p.para != nil {
+			p.para.prev.valid = false
+		}
+		p.addToCache()
+		return nil
 	}
 
-	for _, fn := range p.opts {
-		fn(&p.options)
+	var explicitLevels []Level
+	if fn := p.options.levelFunc; fn != nil {
+		p.explicitLevels = resizeLevels(p.explicitLevels, len(p.types))
+		for i := range p.explicitLevels {
+			p.explicitLevels[i] = fn(i)
+		}
+		explicitLevels = p.explicitLevels
 	}
-	lvl := level(-1)
-	if p.options.defaultDirection == RightToLeft {
-		lvl = 1
+	if p.para == nil {
+		p.para = &paragraph{}
 	}
-	para, err := newParagraph(p.types, p.pairTypes, p.pairValues, lvl)
+	p.para.maxBracketPairs = p.options.maxBracketPairs
+	p.para.work = workBudget{limit: p.options.workBudget}
+	p.para.keepRuns = p.edited
+	err := p.para.reset(p.types, p.pairTypes, p.pairValues, p.options.level, p.options.defaultLevel, explicitLevels)
 	if err != nil {
-		return Ordering{}, err
+		return err
+	}
+	linebreaks := [1]int{len(p.types)}
+	levels, err := p.para.getLevels(p.levels, linebreaks[:])
+	if err != nil {
+		return err
 	}
+	p.levels = levels
+	p.embeddingLevel = p.para.embeddingLevel
+	p.resolved = true
+	p.addToCache()
+	return nil
+}
 
-	levels := para.getLevels([]int{len(p.types)})
+// leftToRightOnly reports whether all characters of p have level 0, so that
+// the algorithm need not be run. This is the case if the paragraph embedding
+// level is 0 and the text has no character of a class in rtlClasses and no
+// explicit levels: then numbers resolve to L by rule W7 and neutrals to the
+// embedding direction by rules N1 and N2.
+func (p *Paragraph) leftToRightOnly() bool {
+	if p.classes&rtlClasses != 0 || p.options.levelFunc != nil {
+		return false
+	}
+	switch p.options.level {
+	case 0:
+		return true
+	case implicitLevel:
+		// Rules P2 and P3.
+		return p.classes&(1<<L) != 0 || p.options.defaultLevel == 0
+	}
+	return false
+}
+
+// lineLevels returns the levels of the paragraph after applying rule L1 to
+// the lines ending at linebreaks.
+func (p *Paragraph) lineLevels(linebreaks []int) ([]Level, error) {
+	if p.fastPath {
+		// Rule L1 does not change level 0.
+		if err := validateLineBreaks(linebreaks, len(p.types)); err != nil {
+			return nil, err
+		}
+		return p.levels, nil
+	}
+	return p.para.getLevels(nil, linebreaks)
+}
 
-	p.o = calculateOrdering(levels, p.runes)
+// Order computes the visual ordering of all the runs in a Paragraph. The
+// returned Ordering shares memory with p and is invalidated by the next call
+// of Order, SetBytes or SetString.
+func (p *Paragraph) Order() (Ordering, error) {
+	if err := p.resolve(); err != nil {
+		return Ordering{}, err
+	}
+	p.o.calculate(p.levels, p.runes, p.offsets, 0)
 	return p.o, nil
 }
 
+// Levels returns the resolved embedding level of each character of the
+// paragraph, indexed by rune position. Rule L1 is applied as if the paragraph
+// were a single line. The returned slice is invalidated by SetBytes or
+// SetString and must not be modified.
+func (p *Paragraph) Levels() ([]Level, error) {
+	if err := p.resolve(); err != nil {
+		return nil, err
+	}
+	return p.levels, nil
+}
+
 // Line computes the visual ordering of runs for a single line starting and
-// ending at the given positions in the original text.
+// ending at the given positions in the original text. The levels resolved for
+// the whole paragraph are used; only the line rules L1 and L2 are applied to
+// the line.
 func (p *Paragraph) Line(start, end int) (Ordering, error) {
-	lineTypes := p.types[start:end]
-	para, err := newParagraph(lineTypes, p.pairTypes[start:end], p.pairValues[start:end], -1)
+	if err := p.resolve(); err != nil {
+		return Ordering{}, err
+	}
+	if start < 0 || end <= start || end > len(p.types) {
+		return Ordering{}, &RangeError{Start: start, End: end, Len: len(p.types)}
+	}
+	var linebreaks []int
+	if start > 0 {
+		linebreaks = append(linebreaks, start)
+	}
+	linebreaks = append(linebreaks, end)
+	if end < len(p.types) {
+		linebreaks = append(linebreaks, len(p.types))
+	}
+	levels, err := p.lineLevels(linebreaks)
 	if err != nil {
 		return Ordering{}, err
 	}
-	levels := para.getLevels([]int{len(lineTypes)})
-	o := calculateOrdering(levels, p.runes[start:end])
-	return o, nil
+	return p.line(levels, start, end), nil
+}
+
+// Lines computes the visual ordering of runs for each line of the paragraph.
+// The linebreaks are the positions after the last character of each line, so
+// the last value must be the length of the paragraph text in runes. The values
+// must be in strictly increasing order.
+//
+// Like Line, Lines applies only the line rules L1 and L2 to the levels
+// resolved for the whole paragraph.
+func (p *Paragraph) Lines(linebreaks []int) ([]Ordering, error) {
+	if err := p.resolve(); err != nil {
+		return nil, err
+	}
+	levels, err := p.lineLevels(linebreaks)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]Ordering, len(linebreaks))
+	start := 0
+	for i, end := range linebreaks {
+		lines[i] = p.line(levels, start, end)
+		start = end
+	}
+	return lines, nil
+}
+
+// LinesBytes is like Lines, but takes the line breaks as byte offsets into the
+// text passed to SetBytes or SetString. The offsets must be at character
+// boundaries.
+func (p *Paragraph) LinesBytes(linebreaks []int) ([]Ordering, error) {
+	runebreaks := make([]int, len(linebreaks))
+	for i, offset := range linebreaks {
+		pos := p.runeIndex(offset)
+		if pos < 0 || p.offsets[pos] != offset {
+			return nil, &LineBreakError{Index: i, Offset: offset, Reason: notAtBoundary}
+		}
+		runebreaks[i] = pos
+	}
+	return p.Lines(runebreaks)
+}
+
+// line returns the ordering of the line from start to end given the levels
+// of the paragraph after applying rule L1.
+func (p *Paragraph) line(levels []Level, start, end int) Ordering {
+	var o Ordering
+	o.calculate(levels[start:end], p.runes[start:end], p.offsets[start:end+1], start)
+	return o
+}
+
+// LineBytes is like Line, but takes byte offsets into the text passed to
+// SetBytes or SetString. The offsets must be at character boundaries.
+func (p *Paragraph) LineBytes(start, end int) (Ordering, error) {
+	s, e := p.runeIndex(start), p.runeIndex(end)
+	if s < 0 || p.offsets[s] != start {
+		return Ordering{}, &LineBreakError{Index: 0, Offset: start, Reason: notAtBoundary}
+	}
+	if e < 0 || p.offsets[e] != end {
+		return Ordering{}, &LineBreakError{Index: 1, Offset: end, Reason: notAtBoundary}
+	}
+	return p.Line(s, e)
 }
 
-// An Ordering holds the computed visual order of runs of a Paragraph. Calling
-// SetBytes or SetString on the originating Paragraph invalidates an Ordering.
-// The methods of an Ordering should only be called by one goroutine at a time.
+// An Ordering holds the computed visual order of runs of a Paragraph. The runs
+// are stored from left to right. Calling SetBytes or SetString on the
+// originating Paragraph invalidates an Ordering, except for a copy returned by
+// Clone and the Ordering of a BatchResult, which have no originating
+// Paragraph.
+//
+// The methods of an Ordering do not modify it, so an Ordering that has no
+// originating Paragraph is immutable and may be used by several goroutines at
+// once. Equal and Hash allow it to be used as a cache value.
 type Ordering struct {
-	runes      [][]rune
-	directions []Direction
-	startpos   []int
+	runes     [][]rune
+	levels    []Level
+	startpos  []int
+	direction Direction
+
+	// text holds the characters of all runs in logical order and offsets
+	// their byte offsets, followed by the offset of the end of the text. The
+	// text starts at rune position start of the paragraph.
+	text    []rune
+	offsets []int
+	start   int
+
+	// logical holds the start positions and logicalLevels the levels of the
+	// runs in logical order. visual maps the index of a run in logical order
+	// to its index in visual order.
+	logical       []int
+	logicalLevels []Level
+	visual        []int
+
+	// l2 holds the memory used for reordering the runs.
+	l2 reorderer
 }
 
 // Direction reports the directionality of the runs.
 //
 // The direction may be LeftToRight, RightToLeft, Mixed, or Neutral.
 func (o *Ordering) Direction() Direction {
-	return o.directions[0]
+	return o.direction
 }
 
 // NumRuns returns the number of runs.
@@ -255,28 +855,179 @@ func (o *Ordering) NumRuns() int {
 	return len(o.runes)
 }
 
-// Run returns the ith run within the ordering.
+// Run returns the ith run within the ordering. Runs are numbered in visual
+// order, so Run(0) is the leftmost run.
 func (o *Ordering) Run(i int) Run {
+	start := o.startpos[i] - o.start
 	r := Run{
 		runes:     o.runes[i],
-		direction: o.directions[i],
+		level:     o.levels[i],
 		startpos:  o.startpos[i],
+		bytestart: o.offsets[start],
+		byteend:   o.offsets[start+len(o.runes[i])],
 	}
 	return r
 }
 
-// TODO: perhaps with options.
-// // Reorder creates a reader that reads the runes in visual order per character.
-// // Modifiers remain after the runes they modify.
-// func (l *Runs) Reorder() io.Reader {
-// 	panic("unimplemented")
-// }
+// VisualToLogical returns a map from visual to logical rune positions. The ith
+// character from the left is the character at position VisualToLogical()[i]
+// in logical order. Positions are relative to the start of the text of the
+// Ordering.
+func (o *Ordering) VisualToLogical() []int {
+	m := make([]int, 0, len(o.text))
+	for i, run := range o.runes {
+		start := o.startpos[i] - o.start
+		if o.levels[i]&1 == 0 {
+			for j := range run {
+				m = append(m, start+j)
+			}
+		} else {
+			for j := len(run) - 1; j >= 0; j-- {
+				m = append(m, start+j)
+			}
+		}
+	}
+	return m
+}
+
+// LogicalToVisual returns a map from logical to visual rune positions. It is
+// the inverse of VisualToLogical.
+func (o *Ordering) LogicalToVisual() []int {
+	return invertMap(o.VisualToLogical())
+}
+
+// VisualToLogicalBytes is like VisualToLogical, but maps byte offsets. The
+// visual text is the UTF-8 encoding of the characters in visual order, so all
+// bytes of a character stay in their original order.
+func (o *Ordering) VisualToLogicalBytes() []int {
+	if len(o.text) == 0 {
+		return nil
+	}
+	base := o.offsets[0]
+	m := make([]int, 0, o.offsets[len(o.text)]-base)
+	for _, l := range o.VisualToLogical() {
+		for b := o.offsets[l]; b < o.offsets[l+1]; b++ {
+			m = append(m, b-base)
+		}
+	}
+	return m
+}
+
+// LogicalToVisualBytes returns a map from logical to visual byte offsets. It
+// is the inverse of VisualToLogicalBytes.
+func (o *Ordering) LogicalToVisualBytes() []int {
+	return invertMap(o.VisualToLogicalBytes())
+}
+
+// VisualToLogicalClusters is like VisualToLogical, but maps cluster
+// positions. A cluster is a character followed by all nonspacing marks (class
+// NSM) that modify it, which approximates a grapheme cluster. Clusters are
+// never split, so marks stay with their base character.
+func (o *Ordering) VisualToLogicalClusters() []int {
+	// cluster holds the logical cluster number of each character.
+	cluster := make([]int, len(o.text))
+	n := -1
+	for i, r := range o.text {
+		if props, _ := LookupRune(r); i == 0 || props.Class() != NSM {
+			n++
+		}
+		cluster[i] = n
+	}
+
+	m := make([]int, 0, n+1)
+	seen := make([]bool, n+1)
+	for _, l := range o.VisualToLogical() {
+		if c := cluster[l]; !seen[c] {
+			seen[c] = true
+			m = append(m, c)
+		}
+	}
+	return m
+}
+
+// LogicalToVisualClusters returns a map from logical to visual cluster
+// positions. It is the inverse of VisualToLogicalClusters.
+func (o *Ordering) LogicalToVisualClusters() []int {
+	return invertMap(o.VisualToLogicalClusters())
+}
+
+// invertMap returns the inverse of the permutation m.
+func invertMap(m []int) []int {
+	inv := make([]int, len(m))
+	for i, v := range m {
+		inv[v] = i
+	}
+	return inv
+}
+
+// A ReorderOption is an option for Reorder.
+type ReorderOption func(*reorderOptions)
+
+type reorderOptions struct {
+	mirror bool
+}
+
+// MirrorGlyphs causes Reorder to replace characters in right-to-left runs by
+// their mirror glyphs, for example "(" by ")" or "«" by "»". See
+// MirrorGlyph.
+func MirrorGlyphs() ReorderOption {
+	return func(opts *reorderOptions) {
+		opts.mirror = true
+	}
+}
+
+// Reorder creates a reader that reads the runes in visual order per character.
+// Modifiers remain after the runes they modify. The returned reader implements
+// io.WriterTo as well.
+func (o *Ordering) Reorder(opts ...ReorderOption) io.Reader {
+	var ro reorderOptions
+	for _, fn := range opts {
+		fn(&ro)
+	}
+	return bytes.NewReader(o.appendVisual(nil, ro.mirror))
+}
+
+// appendVisual appends the text of the ordering in visual order to b. The
+// clusters of right-to-left runs are reversed, but the characters within a
+// cluster keep their logical order.
+func (o *Ordering) appendVisual(b []byte, mirror bool) []byte {
+	var buf [utf8.UTFMax]byte
+	for i, run := range o.runes {
+		if o.levels[i]&1 == 0 {
+			for _, r := range run {
+				n := utf8.EncodeRune(buf[:], r)
+				b = append(b, buf[:n]...)
+			}
+			continue
+		}
+		end := len(run)
+		for start := end - 1; start >= 0; start-- {
+			props, _ := LookupRune(run[start])
+			if start > 0 && props.Class() == NSM {
+				continue
+			}
+			for _, r := range run[start:end] {
+				if mirror {
+					r, _ = MirrorGlyph(r)
+				}
+				n := utf8.EncodeRune(buf[:], r)
+				b = append(b, buf[:n]...)
+			}
+			end = start
+		}
+	}
+	return b
+}
 
 // A Run is a continuous sequence of characters of a single direction.
 type Run struct {
-	runes     []rune
-	direction Direction
-	startpos  int
+	runes    []rune
+	level    Level
+	startpos int
+
+	// bytestart and byteend are the byte offsets of the run.
+	bytestart int
+	byteend   int
 }
 
 // String returns the text of the run in its original order.
@@ -296,52 +1047,53 @@ func (r *Run) Bytes() []byte {
 
 // Direction reports the direction of the run.
 func (r *Run) Direction() Direction {
-	return r.direction
+	return directionForLevel(r.level)
+}
+
+// Level reports the embedding level of the run.
+func (r *Run) Level() Level {
+	return r.level
 }
 
 // Position of the Run within the text passed to SetBytes or SetString of the
-// originating Paragraph value.
+// originating Paragraph value. The positions are rune indexes and end is the
+// index of the last rune of the run.
 func (r *Run) Pos() (start, end int) {
 	return r.startpos, r.startpos + len(r.runes) - 1
 }
 
+// BytePos returns the byte offsets of the Run within the text passed to
+// SetBytes or SetString of the originating Paragraph value. Unlike Pos, the
+// range is half-open: end is the offset just after the last byte of the run.
+func (r *Run) BytePos() (start, end int) {
+	return r.bytestart, r.byteend
+}
+
 // AppendReverse reverses the order of characters of in, appends them to out,
 // and returns the result. Modifiers will still follow the runes they modify.
-// Brackets are replaced with their counterparts.
+// Characters are replaced with their mirror glyphs, see MirrorGlyph.
 func AppendReverse(out, in []byte) []byte {
-	ret := make([]byte, len(in)+len(out))
-	copy(ret, out)
 	inRunes := bytes.Runes(in)
 
 	for i, r := range inRunes {
-		prop, _ := LookupRune(r)
-		if prop.IsBracket() {
-			inRunes[i] = prop.reverseBracket(r)
-		}
+		inRunes[i], _ = MirrorGlyph(r)
 	}
 
 	for i, j := 0, len(inRunes)-1; i < j; i, j = i+1, j-1 {
 		inRunes[i], inRunes[j] = inRunes[j], inRunes[i]
 	}
-	copy(ret[len(out):], string(inRunes))
-
-	return ret
+	return append(out, string(inRunes)...)
 }
 
 // ReverseString reverses the order of characters in s and returns a new string.
-// Modifiers will still follow the runes they modify. Brackets are replaced with
-// their counterparts.
+// Modifiers will still follow the runes they modify. Characters are replaced
+// with their mirror glyphs, see MirrorGlyph.
 func ReverseString(s string) string {
 	input := []rune(s)
 	li := len(input)
 	ret := make([]rune, li)
 	for i, r := range input {
-		prop, _ := LookupRune(r)
-		if prop.IsBracket() {
-			ret[li-i-1] = prop.reverseBracket(r)
-		} else {
-			ret[li-i-1] = r
-		}
+		ret[li-i-1], _ = MirrorGlyph(r)
 	}
 	return string(ret)
 }
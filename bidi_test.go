@@ -1,8 +1,13 @@
 package sdbidi
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"log"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 type runInformation struct {
@@ -57,15 +62,15 @@ func TestMixed(t *testing.T) {
 	}
 
 	expectedRuns := []runInformation{
-		{"العاشر ليونيكود (", RightToLeft, 0, 16},
-		{"Unicode Conference", LeftToRight, 17, 34},
-		{")، الذي سيعقد في ", RightToLeft, 35, 51},
-		{"10", LeftToRight, 52, 53},
-		{"-", RightToLeft, 54, 54},
-		{"12", LeftToRight, 55, 56},
-		{" آذار ", RightToLeft, 57, 62},
-		{"1997", LeftToRight, 63, 66},
 		{" مبدينة", RightToLeft, 67, 73},
+		{"1997", LeftToRight, 63, 66},
+		{" آذار ", RightToLeft, 57, 62},
+		{"12", LeftToRight, 55, 56},
+		{"-", RightToLeft, 54, 54},
+		{"10", LeftToRight, 52, 53},
+		{")، الذي سيعقد في ", RightToLeft, 35, 51},
+		{"Unicode Conference", LeftToRight, 17, 34},
+		{"العاشر ليونيكود (", RightToLeft, 0, 16},
 	}
 
 	if nr, expected := order.NumRuns(), len(expectedRuns); nr != expected {
@@ -86,6 +91,301 @@ func TestMixed(t *testing.T) {
 	}
 }
 
+func TestNestedLevels(t *testing.T) {
+	str := "abc אבג 123 דהו xyz"
+	p := Paragraph{}
+	p.SetString(str)
+	order, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsLeftToRight() {
+		t.Error("Paragraph should return LeftToRight() == true")
+	}
+	if d := order.Direction(); d != Mixed {
+		t.Errorf("Ordering direction should be %d but got %d", Mixed, d)
+	}
+
+	// The number is at level 2 inside a level 1 run, so the runs have to
+	// be reordered as a whole and not per direction change.
+	expectedRuns := []runInformation{
+		{"abc ", LeftToRight, 0, 3},
+		{" דהו", RightToLeft, 11, 14},
+		{"123", LeftToRight, 8, 10},
+		{"אבג ", RightToLeft, 4, 7},
+		{" xyz", LeftToRight, 15, 18},
+	}
+
+	if nr, expected := order.NumRuns(), len(expectedRuns); nr != expected {
+		t.Fatalf("Number of runs must be %d but got %d", expected, nr)
+	}
+
+	for i, er := range expectedRuns {
+		r := order.Run(i)
+		if str := r.String(); str != er.str {
+			t.Errorf("Run %d should have string %q but has %q", i, er.str, str)
+		}
+		if s, e := r.Pos(); s != er.start || e != er.end {
+			t.Errorf("Run %d should go from %d to %d but got %d to %d", i, er.start, er.end, s, e)
+		}
+		if d := r.Direction(); d != er.dir {
+			t.Errorf("Run %d direction should be %d but got %d", i, er.dir, d)
+		}
+	}
+
+	if r := p.RunAt(9); r.String() != "123" {
+		t.Errorf("RunAt(9) should return %q but got %q", "123", r.String())
+	}
+	for _, pos := range []int{-3, -1, len(p.runes), 100} {
+		if r := p.RunAt(pos); r.String() != "" {
+			t.Errorf("RunAt(%d) should return the zero Run but got %q", pos, r.String())
+		}
+	}
+}
+
+func TestLevels(t *testing.T) {
+	str := "abc אבג 123 דהו xyz"
+	p := Paragraph{}
+	p.SetString(str)
+	levels, err := p.Levels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Level{0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 0, 0, 0, 0}
+	if len(levels) != len(expected) {
+		t.Fatalf("Levels should return %d levels but got %d", len(expected), len(levels))
+	}
+	for i, lvl := range expected {
+		if levels[i] != lvl {
+			t.Errorf("Level at %d should be %d but got %d", i, lvl, levels[i])
+		}
+	}
+
+	order, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRunLevels := []Level{0, 1, 2, 1, 0}
+	for i, lvl := range expectedRunLevels {
+		r := order.Run(i)
+		if l := r.Level(); l != lvl {
+			t.Errorf("Run %d level should be %d but got %d", i, lvl, l)
+		}
+	}
+}
+
+func TestParagraphDirection(t *testing.T) {
+	tests := []struct {
+		str string
+		dir Direction
+	}{
+		{"abc", LeftToRight},
+		{"אבג", RightToLeft},
+		{"abc אבג", Mixed},
+		{"", Neutral},
+	}
+	for _, tc := range tests {
+		p := Paragraph{}
+		p.SetString(tc.str)
+		// Direction must not depend on a previous call of Order.
+		if d := p.Direction(); d != tc.dir {
+			t.Errorf("%q: Direction should be %d but got %d", tc.str, tc.dir, d)
+		}
+		if ltr := tc.dir != RightToLeft; tc.dir != Mixed && p.IsLeftToRight() != ltr {
+			t.Errorf("%q: IsLeftToRight should return %t", tc.str, ltr)
+		}
+	}
+}
+
+func TestBracketPairs(t *testing.T) {
+	// The closing bracket only resolves to R if it pairs with the opening
+	// bracket.
+	tests := []string{
+		"\u05D0 (a) b",
+		"\u05D0 \u2329a\u232A b",
+		"\u05D0 \u2329a\u3009 b",
+		"\u05D0 \u3008a\u232A b",
+	}
+	expected := []Level{1, 1, 1, 2, 1, 1, 2}
+	for _, str := range tests {
+		p := Paragraph{}
+		p.SetString(str)
+		levels, err := p.Levels()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(levels) != len(expected) {
+			t.Fatalf("Levels for %q should return %d levels but got %d", str, len(expected), len(levels))
+		}
+		for i, lvl := range expected {
+			if levels[i] != lvl {
+				t.Errorf("Level at %d of %q should be %d but got %d", i, str, lvl, levels[i])
+			}
+		}
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIndexMaps(t *testing.T) {
+	p := Paragraph{}
+	p.SetString("abc אבג 123 דהו xyz")
+	order, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []int{0, 1, 2, 3, 14, 13, 12, 11, 8, 9, 10, 7, 6, 5, 4, 15, 16, 17, 18}
+	if m := order.VisualToLogical(); !equalInts(m, expected) {
+		t.Errorf("VisualToLogical should return %v but got %v", expected, m)
+	}
+	l2v := order.LogicalToVisual()
+	for v, l := range expected {
+		if l2v[l] != v {
+			t.Errorf("LogicalToVisual()[%d] should be %d but got %d", l, v, l2v[l])
+		}
+	}
+
+	// A Hebrew letter with a point followed by another letter. The point
+	// stays with its base character when clusters are reordered.
+	p.SetString("\u05D0\u05B8\u05D1")
+	order, err = p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		fn   func() []int
+		want []int
+	}{
+		{"VisualToLogical", order.VisualToLogical, []int{2, 1, 0}},
+		{"LogicalToVisual", order.LogicalToVisual, []int{2, 1, 0}},
+		{"VisualToLogicalBytes", order.VisualToLogicalBytes, []int{4, 5, 2, 3, 0, 1}},
+		{"LogicalToVisualBytes", order.LogicalToVisualBytes, []int{4, 5, 2, 3, 0, 1}},
+		{"VisualToLogicalClusters", order.VisualToLogicalClusters, []int{1, 0}},
+		{"LogicalToVisualClusters", order.LogicalToVisualClusters, []int{1, 0}},
+	}
+	for _, tc := range tests {
+		if got := tc.fn(); !equalInts(got, tc.want) {
+			t.Errorf("%s should return %v but got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestBytePositions(t *testing.T) {
+	p := Paragraph{}
+	p.SetString("abc אבג 123 דהו xyz")
+	order, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][2]int{{0, 4}, {14, 21}, {11, 14}, {4, 11}, {21, 25}}
+	for i, e := range expected {
+		r := order.Run(i)
+		if s, e2 := r.BytePos(); s != e[0] || e2 != e[1] {
+			t.Errorf("Run %d should go from byte %d to %d but got %d to %d", i, e[0], e[1], s, e2)
+		}
+	}
+	if r := p.RunAtByte(5); r.String() != "אבג " {
+		t.Errorf("RunAtByte(5) should return %q but got %q", "אבג ", r.String())
+	}
+	for _, offset := range []int{-1, 25, 100} {
+		if r := p.RunAtByte(offset); r.String() != "" {
+			t.Errorf("RunAtByte(%d) should return the zero Run but got %q", offset, r.String())
+		}
+	}
+
+	line, err := p.LineBytes(4, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := line.NumRuns(); n != 2 {
+		t.Errorf("Number of runs must be 2 but got %d", n)
+	}
+	_, err = p.LineBytes(5, 14)
+	var lbErr *LineBreakError
+	if !errors.As(err, &lbErr) || lbErr.Index != 0 || lbErr.Offset != 5 {
+		t.Errorf("LineBytes must return a LineBreakError for offsets inside a character but got %v", err)
+	}
+}
+
+func TestLines(t *testing.T) {
+	p := Paragraph{}
+	p.SetString("אבג abc def")
+
+	// A line with only left-to-right text keeps the paragraph direction.
+	line, err := p.Line(4, 11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := line.NumRuns(); n != 1 {
+		t.Fatalf("Number of runs must be 1 but got %d", n)
+	}
+	r := line.Run(0)
+	if l := r.Level(); l != 2 {
+		t.Errorf("Run level should be 2 but got %d", l)
+	}
+	if s, e := r.Pos(); s != 4 || e != 10 {
+		t.Errorf("Run should go from 4 to 10 but got %d to %d", s, e)
+	}
+
+	lines, err := p.Lines([]int{8, 11})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The trailing space of the first line is reset to the paragraph level
+	// by rule L1.
+	expectedRuns := [][]runInformation{
+		{
+			{" ", RightToLeft, 7, 7},
+			{"abc", LeftToRight, 4, 6},
+			{"אבג ", RightToLeft, 0, 3},
+		},
+		{
+			{"def", LeftToRight, 8, 10},
+		},
+	}
+	for l, expected := range expectedRuns {
+		if nr := lines[l].NumRuns(); nr != len(expected) {
+			t.Errorf("Number of runs of line %d must be %d but got %d", l, len(expected), nr)
+			continue
+		}
+		for i, er := range expected {
+			r := lines[l].Run(i)
+			if str := r.String(); str != er.str {
+				t.Errorf("Line %d run %d should have string %q but has %q", l, i, er.str, str)
+			}
+			if s, e := r.Pos(); s != er.start || e != er.end {
+				t.Errorf("Line %d run %d should go from %d to %d but got %d to %d", l, i, er.start, er.end, s, e)
+			}
+			if d := r.Direction(); d != er.dir {
+				t.Errorf("Line %d run %d direction should be %d but got %d", l, i, er.dir, d)
+			}
+		}
+	}
+
+	linesBytes, err := p.LinesBytes([]int{11, 14})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := linesBytes[len(linesBytes)-1].Run(0); len(linesBytes) != 2 || r.String() != "def" {
+		t.Error("LinesBytes should break the paragraph like Lines")
+	}
+
+	if _, err = p.Lines([]int{8, 10}); !errors.Is(err, ErrBadLineBreaks) {
+		t.Errorf("Lines must return ErrBadLineBreaks if the last break is not at the end of the paragraph but got %v", err)
+	}
+}
+
 func TestExplicitIsolate(t *testing.T) {
 	// https://www.w3.org/International/articles/inline-bidi-markup/uba-basics.en#beyond
 	str := "The names of these states in Arabic are \u2067مصر\u2069, \u2067البحرين\u2069 and \u2067الكويت\u2069 respectively."
@@ -278,12 +578,241 @@ func TestDefaultDirection(t *testing.T) {
 
 }
 
+func TestForceDirection(t *testing.T) {
+	tests := []struct {
+		str  string
+		opts []Option
+		ltr  bool
+	}{
+		{"abc", []Option{DefaultDirection(RightToLeft)}, true},
+		{"abc", []Option{ForceDirection(RightToLeft)}, false},
+		{"אבג", []Option{ForceDirection(LeftToRight)}, true},
+		{"אבג", []Option{DefaultDirection(LeftToRight)}, false},
+		{"+", []Option{DefaultDirection(RightToLeft), ForceDirection(LeftToRight)}, true},
+		// options must not leak into the next SetString
+		{"+", nil, true},
+	}
+	p := Paragraph{}
+	for _, tc := range tests {
+		if _, err := p.SetString(tc.str, tc.opts...); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p.Order(); err != nil {
+			t.Fatal(err)
+		}
+		if dir := p.IsLeftToRight(); dir != tc.ltr {
+			t.Errorf("Paragraph %q isLeftToRight should return %t but got %t", tc.str, tc.ltr, dir)
+		}
+	}
+
+	p.SetString("abc", ForceDirection(RightToLeft))
+	levels, err := p.Levels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if levels[0] != 2 {
+		t.Errorf("Level of forced left-to-right text should be 2 but got %d", levels[0])
+	}
+
+	for _, opt := range []Option{DefaultDirection(Mixed), DefaultDirection(Neutral), ForceDirection(Mixed), ForceDirection(Neutral)} {
+		if _, err := p.SetString("abc", opt); !errors.Is(err, ErrInvalidDirection) {
+			t.Errorf("SetString must return ErrInvalidDirection for an invalid direction but got %v", err)
+		}
+	}
+}
+
+func TestLevelFunc(t *testing.T) {
+	// An embedding supplied by LevelFunc must give the same levels as the
+	// RLE ... PDF embedding.
+	p := Paragraph{}
+	p.SetString("abc \u202Bdef אבג\u202C jkl")
+	embedded, err := p.Levels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	embedded = append(embedded[:4:4], append(embedded[5:12:12], embedded[13:]...)...)
+
+	p.SetString("abc def אבג jkl", LevelFunc(func(pos int) Level {
+		if pos >= 4 && pos < 11 {
+			return 1
+		}
+		return 0
+	}))
+	levels, err := p.Levels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(levels) != len(embedded) {
+		t.Fatalf("Levels should return %d levels but got %d", len(embedded), len(levels))
+	}
+	for i := range levels {
+		if levels[i] != embedded[i] {
+			t.Errorf("Level at %d should be %d but got %d", i, embedded[i], levels[i])
+		}
+	}
+
+	// Explicit formatting characters are ignored.
+	p.SetString("abc \u202Ejkl", LevelFunc(func(int) Level { return 0 }))
+	levels, err = p.Levels()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, lvl := range levels {
+		if lvl != 0 {
+			t.Errorf("Level at %d should be 0 but got %d", i, lvl)
+		}
+	}
+
+	p.SetString("abc", LevelFunc(func(int) Level { return 126 }))
+	if _, err = p.Order(); !errors.Is(err, ErrInvalidLevel) {
+		t.Errorf("Order must return ErrInvalidLevel for levels above 125 but got %v", err)
+	}
+}
+
+func TestReorder(t *testing.T) {
+	p := Paragraph{}
+	p.SetString("abc \u05D0\u05B8\u05D1 (\u05D2\u05D3) \u05D4 xyz")
+	order, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		opts     []ReorderOption
+		expected string
+	}{
+		{nil, "abc \u05D4 )\u05D3\u05D2( \u05D1\u05D0\u05B8 xyz"},
+		{[]ReorderOption{MirrorGlyphs()}, "abc \u05D4 (\u05D3\u05D2) \u05D1\u05D0\u05B8 xyz"},
+	}
+	for _, tc := range tests {
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(order.Reorder(tc.opts...)); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != tc.expected {
+			t.Errorf("Reorder should return %q but got %q", tc.expected, got)
+		}
+
+		buf.Reset()
+		wt, ok := order.Reorder(tc.opts...).(io.WriterTo)
+		if !ok {
+			t.Fatal("Reorder should return an io.WriterTo")
+		}
+		if _, err := wt.WriteTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != tc.expected {
+			t.Errorf("WriteTo should write %q but wrote %q", tc.expected, got)
+		}
+	}
+}
+
 func TestEmpty(t *testing.T) {
 	p := Paragraph{}
 	p.SetBytes([]byte{})
 	_, err := p.Order()
-	if err == nil {
-		t.Error("p.Order must return an error on empty input")
+	if !errors.Is(err, ErrEmptyParagraph) {
+		t.Errorf("p.Order must return ErrEmptyParagraph on empty input but got %v", err)
+	}
+	if r := p.RunAt(0); r.String() != "" {
+		t.Errorf("RunAt must return the zero Run for an unordered paragraph but got %q", r.String())
+	}
+	if p, n := Lookup(nil); p.Class() != L || n != 0 {
+		t.Errorf("Lookup must return size 0 on empty input but got %d", n)
+	}
+	if p, n := LookupString(""); p.Class() != L || n != 0 {
+		t.Errorf("LookupString must return size 0 on empty input but got %d", n)
+	}
+}
+
+func TestLineErrors(t *testing.T) {
+	p := Paragraph{}
+	p.SetString("abc אבג")
+	for _, tc := range [][2]int{{-1, 3}, {3, 3}, {4, 2}, {0, 8}} {
+		_, err := p.Line(tc[0], tc[1])
+		var rErr *RangeError
+		if !errors.As(err, &rErr) || !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Line(%d, %d) must return a RangeError but got %v", tc[0], tc[1], err)
+		} else if rErr.Start != tc[0] || rErr.End != tc[1] || rErr.Len != 7 {
+			t.Errorf("Line(%d, %d) returns the wrong RangeError %+v", tc[0], tc[1], rErr)
+		}
+	}
+	for _, linebreaks := range [][]int{nil, {4, 4, 7}, {3, 2, 7}, {3, 6}} {
+		if _, err := p.Lines(linebreaks); !errors.Is(err, ErrBadLineBreaks) {
+			t.Errorf("Lines(%v) must return ErrBadLineBreaks but got %v", linebreaks, err)
+		}
+	}
+	_, err := p.LinesBytes([]int{4, 5, 10})
+	var lbErr *LineBreakError
+	if !errors.As(err, &lbErr) || lbErr.Index != 1 || lbErr.Offset != 5 {
+		t.Errorf("LinesBytes must return a LineBreakError for offsets inside a character but got %v", err)
+	}
+}
+
+func TestMaxLength(t *testing.T) {
+	p := Paragraph{}
+	n, err := p.SetString("abc אבג\nx", MaxLength(6))
+	var lErr *LimitError
+	if !errors.As(err, &lErr) || !errors.Is(err, ErrLimitExceeded) || lErr.Limit != "MaxLength" || lErr.Max != 6 || n != 0 {
+		t.Errorf("SetString must return 0 and a LimitError for a paragraph that is too long but got %d, %v", n, err)
+	}
+	if _, err := p.Order(); !errors.Is(err, ErrEmptyParagraph) {
+		t.Errorf("A paragraph that is too long must be empty but Order returns %v", err)
+	}
+	if n, err := p.SetString("abc אבג\nxxxxxxxx", MaxLength(7)); err != nil || n != 11 {
+		t.Errorf("SetString must accept a paragraph of the maximum length but got %d, %v", n, err)
+	}
+	var d Document
+	if err := d.SetString("abc\nabcdef", MaxLength(5)); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Document.SetString must return ErrLimitExceeded for a paragraph that is too long but got %v", err)
+	}
+}
+
+func TestMaxBracketPairs(t *testing.T) {
+	// The last closing bracket gets the direction of the surrounding Hebrew
+	// letters unless it is paired by rule N0.
+	str := "a(א)a(א)א"
+	for _, tc := range []struct {
+		max  int
+		want []Level
+	}{
+		{-1, []Level{0, 0, 1, 0, 0, 0, 1, 0, 1}},
+		{1, []Level{0, 0, 1, 0, 0, 0, 1, 1, 1}},
+		{0, []Level{0, 0, 1, 0, 0, 0, 1, 1, 1}},
+	} {
+		p := Paragraph{}
+		p.SetString(str, ForceDirection(LeftToRight), MaxBracketPairs(tc.max))
+		levels, err := p.Levels()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, lvl := range levels {
+			if lvl != tc.want[i] {
+				t.Errorf("MaxBracketPairs(%d): levels should be %v but are %v", tc.max, tc.want, levels)
+				break
+			}
+		}
+	}
+}
+
+func TestWorkBudget(t *testing.T) {
+	str := "abc אבג (12.5%)"
+	p := Paragraph{}
+	p.SetString(str, WorkBudget(20))
+	_, err := p.Order()
+	var lErr *LimitError
+	if !errors.As(err, &lErr) || !errors.Is(err, ErrLimitExceeded) || lErr.Limit != "WorkBudget" || lErr.Max != 20 {
+		t.Errorf("Order must return a LimitError if the work budget is exhausted but got %v", err)
+	}
+	if _, err := p.Levels(); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Levels must return ErrLimitExceeded if the work budget is exhausted but got %v", err)
+	}
+	p.SetString(str, WorkBudget(10*len(str)))
+	if _, err := p.Order(); err != nil {
+		t.Errorf("Order must succeed within the work budget but got %v", err)
+	}
+	p.SetString("abc def", WorkBudget(0))
+	if _, err := p.Order(); err != nil {
+		t.Errorf("Left-to-right text must not take any steps but got %v", err)
 	}
 }
 
@@ -297,6 +826,13 @@ func TestNewline(t *testing.T) {
 	if expected := 6; n != expected {
 		t.Errorf("Length of SetString: expected %d but got %d", expected, n)
 	}
+	order, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := order.Run(0); r.String() != "Hello" {
+		t.Errorf("Run 0 should have string %q but has %q", "Hello", r.String())
+	}
 }
 
 func TestDoubleSetString(t *testing.T) {
@@ -317,6 +853,267 @@ func TestDoubleSetString(t *testing.T) {
 
 }
 
+func TestReset(t *testing.T) {
+	p := Paragraph{}
+	p.SetString("abc אבג")
+	if _, err := p.Order(); err != nil {
+		t.Fatal(err)
+	}
+	p.Reset()
+	if _, err := p.Order(); !errors.Is(err, ErrEmptyParagraph) {
+		t.Errorf("Order must return ErrEmptyParagraph after Reset but got %v", err)
+	}
+
+	// A reused paragraph must give the same result as a new one.
+	str := "אב (cd) ⁧ef⁩ [גד] 12"
+	p.SetString(str, ForceDirection(LeftToRight))
+	reused, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := Paragraph{}
+	q.SetString(str, ForceDirection(LeftToRight))
+	fresh, err := q.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reused.NumRuns() != fresh.NumRuns() {
+		t.Fatalf("Reused paragraph has %d runs but a new one has %d", reused.NumRuns(), fresh.NumRuns())
+	}
+	for i := 0; i < fresh.NumRuns(); i++ {
+		r, f := reused.Run(i), fresh.Run(i)
+		if r.String() != f.String() || r.Level() != f.Level() {
+			t.Errorf("Run %d of the reused paragraph is %q at level %d but should be %q at level %d", i, r.String(), r.Level(), f.String(), f.Level())
+		}
+	}
+}
+
+func TestParagraphAllocs(t *testing.T) {
+	texts := []string{
+		"abc אבג (def) 123",
+		"العاشر ليونيكود (Unicode Conference)، ⁧abc [x]⁩ ١٢٣",
+		"‫אב‪cd‬‬ {[(e)]} ",
+		"a",
+	}
+	var bytes [][]byte
+	for _, s := range texts {
+		bytes = append(bytes, []byte(s))
+	}
+	rtl := []Option{DefaultDirection(RightToLeft)}
+	p := Paragraph{}
+	allocs := testing.AllocsPerRun(100, func() {
+		for i, s := range texts {
+			if _, err := p.SetString(s, rtl...); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := p.Order(); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := p.SetBytes(bytes[i]); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := p.Levels(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Processing paragraphs with a reused Paragraph should not allocate but allocates %v times", allocs)
+	}
+}
+
+// fullLevels resolves the text of p with the bidi algorithm, bypassing the
+// fast path for left-to-right text.
+func fullLevels(para *paragraph, p *Paragraph) ([]Level, error) {
+	para.maxBracketPairs = p.options.maxBracketPairs
+	para.work = workBudget{limit: p.options.workBudget}
+	if err := para.reset(p.types, p.pairTypes, p.pairValues, p.options.level, p.options.defaultLevel, nil); err != nil {
+		return nil, err
+	}
+	return para.getLevels(nil, []int{len(p.types)})
+}
+
+func TestLeftToRightFastPath(t *testing.T) {
+	tests := []struct {
+		str      string
+		opts     []Option
+		fastPath bool
+	}{
+		{"Hello, World!", nil, true},
+		{"1.5 + 2,3 = $3.8 (20%)\t[x]", nil, true},
+		{"a\u0300b\u00ADc \u2028 d", nil, true},
+		{"12 + 3", []Option{DefaultDirection(RightToLeft)}, false},
+		{"abc 12", []Option{DefaultDirection(RightToLeft)}, true},
+		{"abc 12", []Option{ForceDirection(LeftToRight)}, true},
+		{"abc 12", []Option{ForceDirection(RightToLeft)}, false},
+		{"abc \u05D0", nil, false},
+		{"abc \u0661", nil, false},
+		{"abc \u2066d\u2069", nil, false},
+		{"abc", []Option{LevelFunc(func(int) Level { return 0 })}, false},
+	}
+	var para paragraph
+	for _, tc := range tests {
+		p := Paragraph{}
+		p.SetString(tc.str, tc.opts...)
+		levels, err := p.Levels()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.fastPath != tc.fastPath {
+			t.Errorf("%q: fast path should be %t", tc.str, tc.fastPath)
+		}
+		want, err := fullLevels(&para, &p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(levels) != len(want) || p.IsLeftToRight() != (para.embeddingLevel == 0) {
+			t.Fatalf("%q: levels %v differ from %v of the full algorithm", tc.str, levels, want)
+		}
+		for i := range want {
+			if levels[i] != want[i] {
+				t.Errorf("%q: levels %v differ from %v of the full algorithm", tc.str, levels, want)
+				break
+			}
+		}
+		if _, err := p.Line(1, len(want)); err != nil {
+			t.Errorf("%q: %v", tc.str, err)
+		}
+	}
+}
+
+var latinText = "The quick brown fox jumps over the lazy dog (again), 12.5% of 1,000 times."
+
+func BenchmarkLatin(b *testing.B) {
+	p := Paragraph{}
+	for i := 0; i < b.N; i++ {
+		p.SetString(latinText)
+		if _, err := p.Order(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLatinFullAlgorithm(b *testing.B) {
+	p := Paragraph{}
+	var para paragraph
+	for i := 0; i < b.N; i++ {
+		p.SetString(latinText)
+		levels, err := fullLevels(&para, &p)
+		if err != nil {
+			b.Fatal(err)
+		}
+		p.o.calculate(levels, p.runes, p.offsets, 0)
+	}
+}
+
+// pathologicalTexts holds long inputs that used to take quadratic time.
+var pathologicalTexts = []struct {
+	name string
+	text string
+}{
+	{"Isolates", strings.Repeat("\u2067", 20000) + "abc"},
+	{"NestedIsolates", strings.Repeat("\u2067a\u2066\u05D0", 60) + strings.Repeat("\u2069", 120)},
+	{"Embeddings", strings.Repeat("\u202Ba\u202A\u05D0", 60) + strings.Repeat("1 ", 10000)},
+	{"AlternatingLevels", strings.Repeat("\u202B\u202A\u202B\u202A\u202B\u202A", 20) + strings.Repeat("a\u05D0", 10000)},
+	{"Numbers", "\u05D0 " + strings.Repeat("1 ", 20000)},
+	{"Terminators", "a" + strings.Repeat("$", 20000) + "b"},
+	{"Neutrals", "\u05D0" + strings.Repeat(" ", 20000) + "a"},
+	{"Brackets", "\u05D0" + strings.Repeat("(a[b]{c})", 2000)},
+	{"Long", strings.Repeat("abc \u05D0\u05D1\u05D2 (12.5%) ", 2000)},
+}
+
+func TestPathological(t *testing.T) {
+	for _, tc := range pathologicalTexts {
+		p := Paragraph{}
+		p.SetString(tc.text)
+		o, err := p.Order()
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		covered := 0
+		for i := 0; i < o.NumRuns(); i++ {
+			r := o.Run(i)
+			start, end := r.Pos()
+			covered += end - start + 1
+			if got := p.RunAt(start); got.startpos != start {
+				t.Errorf("%s: RunAt(%d) returns the run at %d", tc.name, start, got.startpos)
+			}
+		}
+		if covered != len(p.runes) {
+			t.Errorf("%s: runs cover %d of %d characters", tc.name, covered, len(p.runes))
+		}
+	}
+}
+
+// reorderNaive applies rule L2 as written: from the highest level down to the
+// lowest odd level, any contiguous sequence at that level or higher is
+// reversed.
+func reorderNaive(levels []Level) []int {
+	order := make([]int, len(levels))
+	highest, lowestOdd := Level(0), Level(maxDepth+2)
+	for i, l := range levels {
+		order[i] = i
+		if l > highest {
+			highest = l
+		}
+		if l&1 != 0 && l < lowestOdd {
+			lowestOdd = l
+		}
+	}
+	for level := highest; level >= lowestOdd; level-- {
+		for i := 0; i < len(order); i++ {
+			if levels[order[i]] < level {
+				continue
+			}
+			j := i
+			for j < len(order) && levels[order[j]] >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				order[a], order[b] = order[b], order[a]
+			}
+			i = j
+		}
+	}
+	return order
+}
+
+func TestComputeReordering(t *testing.T) {
+	seed := uint32(1)
+	for n := 1; n < 200; n++ {
+		levels := make([]Level, n)
+		for i := range levels {
+			seed = seed*1664525 + 1013904223
+			levels[i] = Level((seed >> 24) % uint32(n%7+2))
+		}
+		got, want := computeReordering(levels), reorderNaive(levels)
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("levels %v: got order %v, want %v", levels, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkPathological(b *testing.B) {
+	for _, tc := range pathologicalTexts {
+		b.Run(tc.name, func(b *testing.B) {
+			p := Paragraph{}
+			for i := 0; i < b.N; i++ {
+				p.SetString(tc.text)
+				o, err := p.Order()
+				if err != nil {
+					b.Fatal(err)
+				}
+				for j := 0; j < len(p.runes); j += 100 {
+					p.RunAt(j)
+				}
+				o.VisualToLogical()
+			}
+		})
+	}
+}
+
 func TestReverseString(t *testing.T) {
 	input := "(Hello)"
 	expected := "(olleH)"
@@ -348,3 +1145,621 @@ func TestAppendReverse(t *testing.T) {
 	}
 
 }
+
+func TestMirrorGlyph(t *testing.T) {
+	tests := []struct {
+		r        rune
+		expected rune
+		ok       bool
+		mirrored bool
+	}{
+		{'(', ')', true, true},
+		{']', '[', true, true},
+		{'«', '»', true, true},
+		{'≤', '≥', true, true},
+		{'∈', '∋', true, true},
+		{'⊃', '⊂', true, true},
+		{'∁', '∁', false, true},
+		{'a', 'a', false, false},
+		{'\u05D0', '\u05D0', false, false},
+	}
+	for _, tc := range tests {
+		r, ok := MirrorGlyph(tc.r)
+		if r != tc.expected || ok != tc.ok {
+			t.Errorf("MirrorGlyph(%q) should return %q, %t but got %q, %t", tc.r, tc.expected, tc.ok, r, ok)
+		}
+		if m := IsMirrored(tc.r); m != tc.mirrored {
+			t.Errorf("IsMirrored(%q) should return %t but got %t", tc.r, tc.mirrored, m)
+		}
+	}
+
+	// All brackets must agree with the bracket properties.
+	for r := rune(0); r <= 0x10FFFF; r++ {
+		props, _ := LookupRune(r)
+		if !props.IsBracket() {
+			continue
+		}
+		if m, ok := MirrorGlyph(r); !ok || m != props.reverseBracket(r) {
+			t.Errorf("MirrorGlyph(%U) should return %U but got %U", r, props.reverseBracket(r), m)
+		}
+	}
+
+	if str := ReverseString("a«b≤c"); str != "c≥b»a" {
+		t.Errorf("ReverseString expected %q but got %q", "c≥b»a", str)
+	}
+}
+
+func TestDocument(t *testing.T) {
+	str := "abc\nאבג\n123\r\nxyz"
+	tests := []struct {
+		opts []Option
+		ltr  []bool
+	}{
+		{nil, []bool{true, false, true, true}},
+		{[]Option{InheritDirection()}, []bool{true, false, false, true}},
+	}
+	expectedPos := [][2]int{{0, 3}, {4, 7}, {8, 12}, {13, 15}}
+	expectedBytePos := [][2]int{{0, 4}, {4, 11}, {11, 16}, {16, 19}}
+	expectedText := []string{"abc", "אבג", "123", "xyz"}
+
+	for _, tc := range tests {
+		d := Document{}
+		if err := d.SetString(str, tc.opts...); err != nil {
+			t.Fatal(err)
+		}
+		if n, expected := d.NumParagraphs(), len(tc.ltr); n != expected {
+			t.Fatalf("Number of paragraphs must be %d but got %d", expected, n)
+		}
+		for i, ltr := range tc.ltr {
+			p := d.Paragraph(i)
+			if p.IsLeftToRight() != ltr {
+				t.Errorf("Paragraph %d isLeftToRight should return %t but got %t", i, ltr, p.IsLeftToRight())
+			}
+			if s, e := d.Pos(i); s != expectedPos[i][0] || e != expectedPos[i][1] {
+				t.Errorf("Paragraph %d should go from %d to %d but got %d to %d", i, expectedPos[i][0], expectedPos[i][1], s, e)
+			}
+			if s, e := d.BytePos(i); s != expectedBytePos[i][0] || e != expectedBytePos[i][1] {
+				t.Errorf("Paragraph %d should go from byte %d to %d but got %d to %d", i, expectedBytePos[i][0], expectedBytePos[i][1], s, e)
+			}
+			order, err := p.Order()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r := order.Run(0); r.String() != expectedText[i] {
+				t.Errorf("Paragraph %d should have text %q but has %q", i, expectedText[i], r.String())
+			}
+		}
+	}
+}
+
+func TestBatch(t *testing.T) {
+	texts := []string{"abc", "אבג", "abc אבג (12.5%)\nxyz", "", "العاشر ليونيكود (Unicode Conference)،", "⁧abc⁩ אבג"}
+	for _, tc := range pathologicalTexts {
+		texts = append(texts, tc.text)
+	}
+	inputs := make([][]byte, 0, 3*len(texts))
+	for i := 0; i < 3; i++ {
+		for _, s := range texts {
+			inputs = append(inputs, []byte(s))
+		}
+	}
+
+	b := Batch{Workers: 4}
+	results := b.Order(inputs, DefaultDirection(RightToLeft))
+	if len(results) != len(inputs) {
+		t.Fatalf("Order should return %d results but returns %d", len(inputs), len(results))
+	}
+	// Reusing the Batch must not change the previous results.
+	b.Order(inputs[:len(texts)], ForceDirection(LeftToRight))
+
+	for i, r := range results {
+		p := Paragraph{}
+		n, _ := p.SetBytes(inputs[i], DefaultDirection(RightToLeft))
+		o, err := p.Order()
+		if r.N != n || !errors.Is(r.Err, err) {
+			t.Errorf("Input %d: result should have N %d and error %v but has %d and %v", i, n, err, r.N, r.Err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		levels, _ := p.Levels()
+		if levelsString(r.Levels) != levelsString(levels) {
+			t.Errorf("Input %d: levels should be %v but are %v", i, levels, r.Levels)
+		}
+		if r.Ordering.NumRuns() != o.NumRuns() {
+			t.Errorf("Input %d: ordering should have %d runs but has %d", i, o.NumRuns(), r.Ordering.NumRuns())
+			continue
+		}
+		for j := 0; j < o.NumRuns(); j++ {
+			got, want := r.Ordering.Run(j), o.Run(j)
+			if got.String() != want.String() || got.Level() != want.Level() || got.startpos != want.startpos || got.bytestart != want.bytestart {
+				t.Errorf("Input %d: run %d should be %q at level %d but is %q at level %d", i, j, want.String(), want.Level(), got.String(), got.Level())
+			}
+		}
+	}
+}
+
+// levelsString returns the levels as a string for comparison.
+func levelsString(levels []Level) string {
+	b := make([]byte, len(levels))
+	for i, l := range levels {
+		b[i] = byte(l)
+	}
+	return string(b)
+}
+
+func BenchmarkBatch(b *testing.B) {
+	inputs := make([][]byte, 10000)
+	for i := range inputs {
+		inputs[i] = []byte("abc \u05D0\u05D1\u05D2 (12.5%) def")
+	}
+	var batch Batch
+	for i := 0; i < b.N; i++ {
+		batch.Order(inputs)
+	}
+}
+
+// checkEdited compares the results for p with the ones for a new Paragraph
+// for the text.
+func checkEdited(t *testing.T, p *Paragraph, text string, opts ...Option) {
+	t.Helper()
+	q := Paragraph{}
+	q.SetString(text, opts...)
+	want, wantErr := q.Order()
+	got, err := p.Order()
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("%q: Order should return %v but returns %v", text, wantErr, err)
+	}
+	if err != nil {
+		return
+	}
+	levels, _ := p.Levels()
+	wantLevels, _ := q.Levels()
+	if p.IsLeftToRight() != q.IsLeftToRight() || levelsString(levels) != levelsString(wantLevels) {
+		t.Fatalf("%q: levels should be %v but are %v", text, wantLevels, levels)
+	}
+	if got.NumRuns() != want.NumRuns() {
+		t.Fatalf("%q: ordering should have %d runs but has %d", text, want.NumRuns(), got.NumRuns())
+	}
+	for i := 0; i < want.NumRuns(); i++ {
+		g, w := got.Run(i), want.Run(i)
+		gs, ge := g.BytePos()
+		ws, we := w.BytePos()
+		if g.String() != w.String() || g.Level() != w.Level() || g.startpos != w.startpos || gs != ws || ge != we {
+			t.Fatalf("%q: run %d should be %q at %d-%d but is %q at %d-%d", text, i, w.String(), ws, we, g.String(), gs, ge)
+		}
+	}
+}
+
+func TestEdit(t *testing.T) {
+	texts := []string{
+		"",
+		"abc def",
+		"abc אבג (12.5%) ⁧xyz⁩ def",
+		"العاشر ليونيكود (Unicode Conference)، ١٢٣",
+		"‫a‭b‮c‬d‬e‪ [אב] f",
+	}
+	inserts := []string{"", "a", "א", " ", "(", ")", "[x]", "1", "١", "⁧", "⁩", "‫", "‬", "̀", "$", "abc אבג"}
+	seed := uint32(7)
+	random := func(n int) int {
+		seed = seed*1664525 + 1013904223
+		return int(seed>>8) % n
+	}
+	for _, text := range texts {
+		for _, opts := range [][]Option{nil, {ForceDirection(RightToLeft)}} {
+			p := Paragraph{}
+			p.SetString(text, opts...)
+			model := []rune(text)
+			for i := 0; i < 200; i++ {
+				start := random(len(model) + 1)
+				end := start + random(len(model)-start+1)
+				if random(3) == 0 {
+					end = start
+				}
+				insert := inserts[random(len(inserts))]
+				if err := p.Replace(start, end, insert); err != nil {
+					t.Fatal(err)
+				}
+				model = append(model[:start:start], append([]rune(insert), model[end:]...)...)
+				// Resolve after some of the edits only, so that edits are
+				// merged as well.
+				if random(3) != 0 {
+					checkEdited(t, &p, string(model), opts...)
+				}
+			}
+		}
+	}
+}
+
+func TestEditReuse(t *testing.T) {
+	text := strings.Repeat("abc אבג ⁧(12.5%)⁩ def. ", 100)
+	p := Paragraph{}
+	p.SetString(text)
+	p.Insert(0, "x")
+	p.Order()
+	// Rules BD9 to X10 take 4 steps per character for every edit.
+	full := p.para.work.steps - 4*len(p.runes)
+
+	// The edit inside the first isolate affects only its isolating run
+	// sequence.
+	p.Insert(11, "א")
+	runes := []rune("x" + text)
+	checkEdited(t, &p, string(runes[:11])+"א"+string(runes[11:]))
+	if steps := p.para.work.steps - 4*len(p.runes); steps >= full/10 {
+		t.Errorf("An edit should reuse the results of unaffected sequences but takes %d of %d steps", steps, full)
+	}
+
+	// A new text is not an edit, so its results are not kept.
+	p.SetString(text)
+	p.Order()
+	if p.edited || p.para.keepRuns {
+		t.Error("SetString should stop keeping the results for reuse after an edit")
+	}
+}
+
+// BenchmarkEdit measures an edit followed by Order. Plain mixed text forms a
+// single isolating run sequence, so nothing can be reused; with isolates only
+// the sequence of the edit is resolved again.
+func BenchmarkEdit(b *testing.B) {
+	for _, bc := range []struct {
+		name, text string
+	}{
+		{"Plain", strings.Repeat("abc אבג (12.5%) def. ", 100)},
+		{"Isolates", strings.Repeat("abc אבג ⁧(12.5%)⁩ def. ", 100)},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			p := Paragraph{}
+			p.SetString(bc.text)
+			p.Insert(0, "x")
+			p.Order()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if i%2 == 0 {
+					p.Insert(11, "א")
+				} else {
+					p.Delete(11, 12)
+				}
+				if _, err := p.Order(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestEditErrors(t *testing.T) {
+	p := Paragraph{}
+	p.SetString("abc", MaxLength(5))
+	for _, tc := range [][2]int{{-1, 1}, {2, 1}, {0, 4}} {
+		if err := p.Replace(tc[0], tc[1], "x"); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Replace(%d, %d) must return ErrOutOfRange but got %v", tc[0], tc[1], err)
+		}
+	}
+	if err := p.Insert(1, "x\ny"); !errors.Is(err, ErrParagraphSeparator) {
+		t.Errorf("Insert must return ErrParagraphSeparator for a paragraph separator but got %v", err)
+	}
+	if err := p.Insert(1, "xyz"); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("Insert must return ErrLimitExceeded if the text gets too long but got %v", err)
+	}
+	checkEdited(t, &p, "abc")
+	if err := p.Delete(0, 3); err != nil {
+		t.Fatal(err)
+	}
+	checkEdited(t, &p, "")
+}
+
+func TestClone(t *testing.T) {
+	p := Paragraph{}
+	p.SetString("abc אבג (12.5%) def")
+	o, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := o.Clone()
+	if !c.Equal(&o) || c.Hash() != o.Hash() {
+		t.Errorf("A clone must be equal to the original and have the same hash")
+	}
+	want := make([]string, o.NumRuns())
+	for i := range want {
+		r := o.Run(i)
+		want[i] = r.String()
+	}
+	wantHash := c.Hash()
+
+	// The clone must not change with the paragraph.
+	p.SetString("xyz אבגד (1) uvw")
+	o2, err := p.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Equal(&o2) || c.Hash() == o2.Hash() {
+		t.Errorf("Orderings of different texts must not be equal")
+	}
+	if c.NumRuns() != len(want) || c.Hash() != wantHash {
+		t.Fatalf("A clone must not change with its paragraph")
+	}
+
+	// The clone can be read by several goroutines at once.
+	done := make(chan bool)
+	for i := 0; i < 4; i++ {
+		go func() {
+			ok := c.Hash() == wantHash
+			for j, s := range want {
+				r := c.Run(j)
+				ok = ok && r.String() == s
+			}
+			ok = ok && len(c.VisualToLogical()) == len([]rune("abc אבג (12.5%) def"))
+			done <- ok
+		}()
+	}
+	for i := 0; i < 4; i++ {
+		if !<-done {
+			t.Errorf("A clone must not change with its paragraph")
+		}
+	}
+
+	var zero Ordering
+	if z := zero.Clone(); !z.Equal(&zero) || z.Hash() != zero.Hash() {
+		t.Errorf("A clone of the zero Ordering must be equal to it")
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := NewCache(2)
+	texts := []string{"אבג abc (12.5%)  \nxyz", "abc def", "⁧abc⁩ אבג\r\n"}
+	for i := 0; i < 2; i++ {
+		for _, text := range texts {
+			p, q := Paragraph{}, Paragraph{}
+			n, err := p.SetString(text, UseCache(c), DefaultDirection(RightToLeft))
+			wantN, _ := q.SetString(text, DefaultDirection(RightToLeft))
+			if err != nil || n != wantN {
+				t.Fatalf("%q: SetString should return %d but returns %d, %v", text, wantN, n, err)
+			}
+			checkEdited(t, &p, string(q.runes), DefaultDirection(RightToLeft))
+			got, err := p.Line(2, len(q.runes))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := q.Line(2, len(q.runes))
+			if !got.Equal(&want) {
+				t.Errorf("%q: Line should be the same for a cached paragraph", text)
+			}
+		}
+	}
+	// With room for two paragraphs, each of three texts in turn has been
+	// evicted before it is set again.
+	if s := c.Stats(); s.Hits != 0 || s.Misses != 6 || s.Evictions != 4 || s.Len != 2 {
+		t.Errorf("Unexpected cache statistics %+v", s)
+	}
+
+	p := Paragraph{}
+	for i := 0; i < 3; i++ {
+		p.SetString(texts[1], UseCache(c))
+		p.Order()
+	}
+	p.SetString(texts[1], UseCache(c), ForceDirection(RightToLeft))
+	p.Order()
+	if s := c.Stats(); s.Hits != 2 || s.Misses != 8 || s.Len != 2 {
+		t.Errorf("Unexpected cache statistics %+v", s)
+	}
+
+	b := []byte(texts[1])
+	if allocs := testing.AllocsPerRun(10, func() {
+		p.SetBytes(b, UseCache(c), ForceDirection(RightToLeft))
+		p.Order()
+	}); allocs != 0 {
+		t.Errorf("A cache hit should not allocate but allocates %v times", allocs)
+	}
+
+	c.SetCapacity(0)
+	p.SetString(texts[1], UseCache(c))
+	// AllocsPerRun runs the function once more to warm up.
+	if s := c.Stats(); s.Len != 0 || s.Hits != 13 || s.Misses != 8 || s.Evictions != 8 {
+		t.Errorf("A disabled cache should be empty and unused but has statistics %+v", s)
+	}
+	// A disabled cache costs no allocations.
+	withCache := testing.AllocsPerRun(10, func() {
+		p.SetString(texts[0], UseCache(c))
+		p.Order()
+	})
+	withoutCache := testing.AllocsPerRun(10, func() {
+		p.SetString(texts[0])
+		p.Order()
+	})
+	if withCache != withoutCache {
+		t.Errorf("A disabled cache should not allocate but allocates %v instead of %v times", withCache, withoutCache)
+	}
+	c.Clear()
+	if s := c.Stats(); s != (CacheStats{}) {
+		t.Errorf("Clear should reset the statistics but they are %+v", s)
+	}
+
+	// The zero value is a disabled cache that SetCapacity enables.
+	var z Cache
+	p.SetString(texts[0], UseCache(&z))
+	p.Order()
+	z.SetCapacity(1)
+	for i := 0; i < 2; i++ {
+		p.SetString(texts[i], UseCache(&z))
+		p.Order()
+	}
+	if s := z.Stats(); s.Len != 1 || s.Misses != 2 || s.Evictions != 1 {
+		t.Errorf("Unexpected statistics %+v of a zero Cache", s)
+	}
+
+	// The workers of a Batch may share a cache.
+	c.SetCapacity(10)
+	var inputs [][]byte
+	for i := 0; i < 100; i++ {
+		inputs = append(inputs, []byte(texts[i%len(texts)]))
+	}
+	batch := Batch{Workers: 4}
+	for _, r := range batch.Order(inputs, UseCache(c)) {
+		if r.Err != nil {
+			t.Fatal(r.Err)
+		}
+	}
+	if s := c.Stats(); s.Hits+s.Misses != int64(len(inputs)) || s.Len != len(texts) {
+		t.Errorf("Unexpected cache statistics %+v after ordering a batch", s)
+	}
+}
+
+func TestParagraphEnd(t *testing.T) {
+	for r := rune(0); r < 0x110000; r++ {
+		if r >= 0xD800 && r <= 0xDFFF {
+			continue
+		}
+		props, _ := LookupRune(r)
+		s := "a" + string(r) + "b"
+		end, n := paragraphEnd(nil, s)
+		if isB := props.Class() == B; (end == 1) != isB || isB && n != len(s)-1 {
+			t.Errorf("%U: paragraphEnd returns %d, %d", r, end, n)
+		}
+		if e, m := paragraphEnd([]byte(s), ""); e != end || m != n {
+			t.Errorf("%U: paragraphEnd returns %d, %d for bytes but %d, %d for a string", r, e, m, end, n)
+		}
+	}
+	if end, n := paragraphEnd(nil, "a\r\nb"); end != 1 || n != 3 {
+		t.Errorf("paragraphEnd should treat CR LF as a single separator but returns %d, %d", end, n)
+	}
+}
+
+// repeatReader returns the bytes of s over and over again, up to n bytes.
+type repeatReader struct {
+	s   string
+	pos int
+	n   int
+}
+
+func (r *repeatReader) Read(b []byte) (int, error) {
+	if r.n <= 0 {
+		return 0, io.EOF
+	}
+	if len(b) > r.n {
+		b = b[:r.n]
+	}
+	for i := range b {
+		b[i] = r.s[r.pos]
+		r.pos = (r.pos + 1) % len(r.s)
+	}
+	r.n -= len(b)
+	return len(b), nil
+}
+
+func TestScanner(t *testing.T) {
+	texts := []string{
+		"",
+		"abc",
+		"abc\nאבג\n123\r\nxyz",
+		"abc\r\rאבג\r\n\r\n(א) 12  xyz\u0085א\x1cb\x1dc\x1e\n",
+		"\xc2\xe2\x80\xff\xe2\x80\xa9\xc2\x85\r",
+		strings.Repeat("abc אבג\n", 1000) + strings.Repeat("⁧א", 3000),
+	}
+	readers := map[string]func(string) io.Reader{
+		"Reader":     func(s string) io.Reader { return strings.NewReader(s) },
+		"OneByte":    func(s string) io.Reader { return iotest.OneByteReader(strings.NewReader(s)) },
+		"DataErr":    func(s string) io.Reader { return iotest.DataErrReader(strings.NewReader(s)) },
+		"HalfReader": func(s string) io.Reader { return iotest.HalfReader(strings.NewReader(s)) },
+	}
+	for _, str := range texts {
+		for _, opts := range [][]Option{nil, {InheritDirection(), DefaultDirection(RightToLeft)}} {
+			var d Document
+			if err := d.SetString(str, opts...); err != nil {
+				t.Fatal(err)
+			}
+			for name, reader := range readers {
+				s := NewScanner(reader(str), opts...)
+				i := 0
+				for ; s.Scan(); i++ {
+					if i >= d.NumParagraphs() {
+						t.Fatalf("%s: Scanner returns more than %d paragraphs for %q", name, d.NumParagraphs(), str)
+					}
+					p, want := s.Paragraph(), d.Paragraph(i)
+					if start, end := s.Pos(); start != int64(d.runeStarts[i]) || end != int64(d.runeStarts[i+1]-1) {
+						t.Errorf("%s: paragraph %d of %q should go from %d to %d but goes from %d to %d", name, i, str, d.runeStarts[i], d.runeStarts[i+1]-1, start, end)
+					}
+					if start, end := s.BytePos(); start != int64(d.starts[i]) || end != int64(d.starts[i+1]) {
+						t.Errorf("%s: paragraph %d of %q should go from byte %d to %d but goes from %d to %d", name, i, str, d.starts[i], d.starts[i+1], start, end)
+					}
+					if string(p.runes) != string(want.runes) || p.IsLeftToRight() != want.IsLeftToRight() {
+						t.Errorf("%s: paragraph %d of %q differs from the paragraph of a Document", name, i, str)
+					}
+					if len(p.runes) == 0 {
+						continue
+					}
+					levels, err := p.Levels()
+					if err != nil {
+						t.Fatal(err)
+					}
+					wantLevels, err := want.Levels()
+					if err != nil {
+						t.Fatal(err)
+					}
+					if levelsString(levels) != levelsString(wantLevels) {
+						t.Errorf("%s: paragraph %d of %q has levels %s, want %s", name, i, str, levelsString(levels), levelsString(wantLevels))
+					}
+				}
+				if err := s.Err(); err != nil {
+					t.Fatalf("%s: %v", name, err)
+				}
+				if i != d.NumParagraphs() {
+					t.Errorf("%s: Scanner returns %d paragraphs for %q, want %d", name, i, str, d.NumParagraphs())
+				}
+			}
+		}
+	}
+
+	// The errors of the reader are reported.
+	s := NewScanner(io.MultiReader(strings.NewReader("abc\nxyz"), iotest.ErrReader(io.ErrUnexpectedEOF)))
+	if !s.Scan() || s.Scan() || !errors.Is(s.Err(), io.ErrUnexpectedEOF) {
+		t.Errorf("Scanner should return the first paragraph and then the error of the reader but returns error %v", s.Err())
+	}
+	s = NewScanner(strings.NewReader("abc"), DefaultDirection(Neutral))
+	if s.Scan() || !errors.Is(s.Err(), ErrInvalidDirection) {
+		t.Errorf("Scanner should return ErrInvalidDirection but returns %v", s.Err())
+	}
+
+	// The memory used is bounded by the longest paragraph.
+	s = NewScanner(&repeatReader{s: "abc אבג (12.5%)\r\n", n: 1 << 22})
+	count := 0
+	for s.Scan() {
+		count++
+	}
+	if err := s.Err(); err != nil || count != 1<<22/len("abc אבג (12.5%)\r\n")+1 {
+		t.Errorf("Scanner returns %d paragraphs with error %v", count, err)
+	}
+	if c := cap(s.buf); c > 2*scanChunk {
+		t.Errorf("Scanner uses a buffer of %d bytes for short paragraphs", c)
+	}
+	s = NewScanner(&repeatReader{s: "אבג", n: 1 << 30}, MaxLength(1000))
+	var le *LimitError
+	if s.Scan() || !errors.As(s.Err(), &le) || le.Limit != "MaxLength" || le.Max != 1000 {
+		t.Errorf("Scanner should return a LimitError for a long paragraph but returns %v", s.Err())
+	}
+	if c := cap(s.buf); c > 4*scanChunk {
+		t.Errorf("Scanner uses a buffer of %d bytes for a paragraph of at most 1000 runes", c)
+	}
+
+	// Once the buffers have grown, scanning does not allocate.
+	s = NewScanner(&repeatReader{s: "abc אבג (12.5%)\r\n123\n", n: 1 << 22}, InheritDirection())
+	for i := 0; i < 10; i++ {
+		s.Scan()
+	}
+	if allocs := testing.AllocsPerRun(100, func() { s.Scan() }); allocs != 0 {
+		t.Errorf("Scan with InheritDirection allocates %v times per paragraph", allocs)
+	}
+}
+
+func BenchmarkScanner(b *testing.B) {
+	const line = "abc אבג (12.5%) العاشر ليونيكود (Unicode Conference)،\n"
+	b.SetBytes(int64(100 * len(line)))
+	for i := 0; i < b.N; i++ {
+		s := NewScanner(&repeatReader{s: line, n: 100 * len(line)})
+		for s.Scan() {
+		}
+		if err := s.Err(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
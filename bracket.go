@@ -4,11 +4,7 @@
 
 package sdbidi
 
-import (
-	"container/list"
-	"fmt"
-	"sort"
-)
+import "fmt"
 
 // This file contains a port of the reference implementation of the
 // Bidi Parentheses Algorithm:
@@ -21,16 +17,16 @@ import (
 // algorithm:
 //  - opening and closing brackets are identified
 //  - a bracket pair type, like '(' and ')' is assigned a unique identifier that
-//    is identical for the opening and closing bracket. It is left to do these
-//    mappings.
+//    is identical for the opening and closing bracket: the rune of the opening
+//    bracket.
 //  - The BPA algorithm requires that bracket characters that are canonical
 //    equivalents of each other be able to be substituted for each other.
-//    It is the responsibility of the caller to do this canonicalization.
+//    canonicalBracket maps the identifier to its canonical form.
 //
 // In implementing BD16, this implementation departs slightly from the "logical"
 // algorithm defined in UAX#9. In particular, the stack referenced there
 // supports operations that go beyond a "basic" stack. An equivalent
-// implementation based on a linked list is used here.
+// implementation based on a slice that is searched from the top is used here.
 
 // Bidi_Paired_Bracket_Type
 // BD14. An opening paired bracket is a character whose
@@ -57,12 +53,20 @@ func (b *bracketPair) String() string {
 	return fmt.Sprintf("(%v, %v)", b.opener, b.closer)
 }
 
-// bracketPairs is a slice of bracketPairs with a sort.Interface implementation.
+// bracketPairs is a slice of bracketPairs sorted by the position of the
+// opening bracket.
 type bracketPairs []bracketPair
 
-func (b bracketPairs) Len() int           { return len(b) }
-func (b bracketPairs) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
-func (b bracketPairs) Less(i, j int) bool { return b[i].opener < b[j].opener }
+// insert adds the pair of brackets at opener and closer at its sorted
+// position.
+func (b *bracketPairs) insert(opener, closer int) {
+	i := len(*b)
+	*b = append(*b, bracketPair{})
+	for ; i > 0 && (*b)[i-1].opener > opener; i-- {
+		(*b)[i] = (*b)[i-1]
+	}
+	(*b)[i] = bracketPair{opener, closer}
+}
 
 // resolvePairedBrackets runs the paired bracket part of the UBA algorithm.
 //
@@ -72,10 +76,17 @@ func (b bracketPairs) Less(i, j int) bool { return b[i].opener < b[j].opener }
 //
 // The identifiers for bracket types are the rune of the canonicalized opening
 // bracket for brackets (open or close) or 0 for runes that are not brackets.
-func resolvePairedBrackets(s *isolatingRunSequence) {
+//
+// The memory of the openers and pairPositions of the paragraph is reused. The
+// bracket pairs are limited by the bracketPairsLeft of the paragraph and the
+// steps taken are accounted for by its work budget.
+func resolvePairedBrackets(s *isolatingRunSequence) error {
 	p := bracketPairer{
 		sos:              s.sos,
-		openers:          list.New(),
+		openers:          s.p.openers[:0],
+		pairPositions:    s.p.pairPositions[:0],
+		pairsLeft:        s.p.bracketPairsLeft,
+		work:             &s.p.work,
 		codesIsolatedRun: s.types,
 		indexes:          s.indexes,
 	}
@@ -84,7 +95,10 @@ func resolvePairedBrackets(s *isolatingRunSequence) {
 		dirEmbed = R
 	}
 	p.locateBrackets(s.p.pairTypes, s.p.pairValues)
-	p.resolveBrackets(dirEmbed, s.p.initialTypes)
+	err := p.resolveBrackets(dirEmbed, s.p.initialTypes)
+	s.p.openers, s.p.pairPositions = p.openers, p.pairPositions
+	s.p.bracketPairsLeft = p.pairsLeft
+	return err
 }
 
 type bracketPairer struct {
@@ -104,21 +118,43 @@ type bracketPairer struct {
 
 	// Bracket characters with canonical decompositions are supposed to be
 	// treated as if they had been normalized, to allow normalized and non-
-	// normalized text to give the same result. In this implementation that step
-	// is pushed out to the caller. The caller has to ensure that the pairValue
-	// slices contain the rune of the opening bracket after normalization for
-	// any opening or closing bracket.
+	// normalized text to give the same result. The pairValue slices contain
+	// the rune of the opening bracket after normalization for any opening or
+	// closing bracket, see canonicalBracket.
 
-	openers *list.List // list of positions for opening brackets
+	openers []int // positions of opening brackets, most recent last
 
 	// bracket pair positions sorted by location of opening bracket
 	pairPositions bracketPairs
 
+	// pairsLeft is the number of bracket pairs that may still be located, or
+	// negative if there is no limit.
+	pairsLeft int
+
+	// work accounts for the steps taken by resolveBrackets, which counts
+	// them in steps.
+	work  *workBudget
+	steps int
+
 	codesIsolatedRun []Class // directional bidi codes for an isolated run
 	indexes          []int   // array of index values into the original string
 
 }
 
+// canonicalBracket returns the canonical decomposition of the bracket r. The
+// only brackets with a canonical decomposition are U+2329 LEFT-POINTING ANGLE
+// BRACKET and U+232A RIGHT-POINTING ANGLE BRACKET, which decompose to U+3008
+// and U+3009.
+func canonicalBracket(r rune) rune {
+	switch r {
+	case 0x2329:
+		return 0x3008
+	case 0x232A:
+		return 0x3009
+	}
+	return r
+}
+
 // matchOpener reports whether characters at given positions form a matching
 // bracket pair.
 func (p *bracketPairer) matchOpener(pairValues []rune, opener, closer int) bool {
@@ -129,9 +165,10 @@ const maxPairingDepth = 63
 
 // locateBrackets locates matching bracket pairs according to BD16.
 //
-// This implementation uses a linked list instead of a stack, because, while
-// elements are added at the front (like a push) they are not generally removed
-// in atomic 'pop' operations, reducing the benefit of the stack archetype.
+// The openers are kept in a slice that is used like a stack, but, while
+// elements are added at the end (like a push) they are not generally removed
+// in atomic 'pop' operations: a closing bracket is matched against the
+// openers from the most recent one and removes all openers above the match.
 func (p *bracketPairer) locateBrackets(pairTypes []bracketType, pairValues []rune) {
 	// traverse the run
 	// do that explicitly (not in a for-each) so we can record position
@@ -145,30 +182,31 @@ func (p *bracketPairer) locateBrackets(pairTypes []bracketType, pairValues []run
 		switch pairTypes[index] {
 		case bpOpen:
 			// check if maximum pairing depth reached
-			if p.openers.Len() == maxPairingDepth {
-				p.openers.Init()
+			if len(p.openers) == maxPairingDepth {
+				p.openers = p.openers[:0]
 				return
 			}
-			// remember opener location, most recent first
-			p.openers.PushFront(i)
+			// remember opener location, most recent last
+			p.openers = append(p.openers, i)
 
 		case bpClose:
 			// see if there is a match
-			count := 0
-			for elem := p.openers.Front(); elem != nil; elem = elem.Next() {
-				count++
-				opener := elem.Value.(int)
+			for j := len(p.openers) - 1; j >= 0; j-- {
+				opener := p.openers[j]
 				if p.matchOpener(pairValues, opener, i) {
+					// stop pairing if the maximum number of pairs is reached
+					if p.pairsLeft == 0 {
+						p.openers = p.openers[:0]
+						return
+					}
+					p.pairsLeft--
 					// if the opener matches, add nested pair to the ordered list
-					p.pairPositions = append(p.pairPositions, bracketPair{opener, i})
+					p.pairPositions.insert(opener, i)
 					// remove up to and including matched opener
-					for ; count > 0; count-- {
-						p.openers.Remove(p.openers.Front())
-					}
+					p.openers = p.openers[:j]
 					break
 				}
 			}
-			sort.Sort(p.pairPositions)
 			// if we get here, the closing bracket matched no openers
 			// and gets ignored
 		}
@@ -252,6 +290,7 @@ func (p *bracketPairer) getStrongTypeN0(index int) Class {
 func (p *bracketPairer) classifyPairContent(loc bracketPair, dirEmbed Class) Class {
 	dirOpposite := ON
 	for i := loc.opener + 1; i < loc.closer; i++ {
+		p.steps++
 		dir := p.getStrongTypeN0(i)
 		if dir == ON {
 			continue
@@ -269,6 +308,7 @@ func (p *bracketPairer) classifyPairContent(loc bracketPair, dirEmbed Class) Cla
 // Pair. Return R or L if strong type found, otherwise ON.
 func (p *bracketPairer) classBeforePair(loc bracketPair) Class {
 	for i := loc.opener - 1; i >= 0; i-- {
+		p.steps++
 		if dir := p.getStrongTypeN0(i); dir != ON {
 			return dir
 		}
@@ -327,9 +367,15 @@ func (p *bracketPairer) setBracketsToType(loc bracketPair, dirPair Class, initia
 	}
 }
 
-// resolveBrackets implements rule N0 for a list of pairs.
-func (p *bracketPairer) resolveBrackets(dirEmbed Class, initialTypes []Class) {
+// resolveBrackets implements rule N0 for a list of pairs. It returns a
+// *LimitError if the work budget is exhausted.
+func (p *bracketPairer) resolveBrackets(dirEmbed Class, initialTypes []Class) error {
 	for _, loc := range p.pairPositions {
+		p.steps = 0
 		p.assignBracketType(loc, dirEmbed, initialTypes)
+		if err := p.work.spend(p.steps); err != nil {
+			return err
+		}
 	}
+	return nil
 }
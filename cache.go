@@ -0,0 +1,300 @@
+package sdbidi
+
+import (
+	"strings"
+	"sync"
+)
+
+// A Cache holds the results of recently resolved paragraphs, so that a
+// Paragraph that is set to the same text with the same options again skips the
+// classification of the characters and the bidi algorithm. A Paragraph uses a
+// Cache if it is passed the UseCache option.
+//
+// A Cache holds up to a given number of paragraphs and evicts the least
+// recently used one if it is full. It may be used by several goroutines at
+// once. The zero value is a disabled Cache; SetCapacity enables it.
+type Cache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[cacheOptions]map[string]*cacheEntry
+	len      int
+	stats    CacheStats
+
+	// root is the sentinel of the list of entries, from the most recently
+	// used one at root.next to the least recently used one at root.prev.
+	root cacheEntry
+}
+
+// CacheStats reports the use of a Cache.
+type CacheStats struct {
+	Hits      int64 // the number of paragraphs found in the cache
+	Misses    int64 // the number of paragraphs not found in the cache
+	Evictions int64 // the number of paragraphs evicted from the cache
+	Len       int   // the number of paragraphs in the cache
+}
+
+// cacheOptions holds the options that affect the result of resolving a
+// paragraph.
+type cacheOptions struct {
+	level, defaultLevel                    Level
+	maxLength, maxBracketPairs, workBudget int
+}
+
+// A cacheEntry holds the text of a paragraph and the result of resolving it.
+// Apart from its place in the list of entries, it is not modified once it has
+// been added to a Cache.
+type cacheEntry struct {
+	opts cacheOptions
+	text string
+
+	runes      []rune
+	offsets    []int
+	types      []Class
+	pairTypes  []bracketType
+	pairValues []rune
+	classes    uint32
+
+	// levels and embeddingLevel are the results of the Paragraph. If
+	// fastPath is not set, resultLevels holds the levels before rule L1.
+	levels         []Level
+	resultLevels   []Level
+	embeddingLevel Level
+	fastPath       bool
+
+	prev, next *cacheEntry
+}
+
+// NewCache returns a Cache for up to capacity paragraphs.
+func NewCache(capacity int) *Cache {
+	c := &Cache{}
+	c.SetCapacity(capacity)
+	return c
+}
+
+// SetCapacity sets the maximum number of paragraphs in c and evicts the least
+// recently used paragraphs if c holds more. A capacity of zero or less
+// disables c: Paragraphs that use it neither look up nor add paragraphs.
+func (c *Cache) SetCapacity(capacity int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.capacity = capacity
+	for c.len > 0 && c.len > capacity {
+		c.remove(c.root.prev)
+		c.stats.Evictions++
+	}
+}
+
+// Stats returns the statistics of c.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.stats
+	s.Len = c.len
+	return s
+}
+
+// Clear removes all paragraphs from c and resets its statistics.
+func (c *Cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = nil
+	c.root.prev, c.root.next = nil, nil
+	c.len = 0
+	c.stats = CacheStats{}
+}
+
+// UseCache causes a Paragraph to look up the results for its text in c and to
+// add them to c once it has resolved a text that is not in c. Options other
+// than UseCache are part of the key, so texts set with different options do
+// not share results. A Paragraph with the LevelFunc option does not use c.
+//
+// The results are copied from c, so a Paragraph set from c behaves like any
+// other, and its Orderings and levels do not share memory with c.
+func UseCache(c *Cache) Option {
+	return func(opts *options) {
+		opts.cache = c
+	}
+}
+
+// lookup returns the entry for the text of the given options or nil. The text
+// is given as b or, if b is nil, as s. It reports whether c is enabled, in
+// which case the text should be added to c once it has been resolved.
+func (c *Cache) lookup(opts cacheOptions, b []byte, s string) (e *cacheEntry, enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.capacity <= 0 {
+		return nil, false
+	}
+	if b != nil {
+		e = c.entries[opts][string(b)]
+	} else {
+		e = c.entries[opts][s]
+	}
+	if e == nil {
+		c.stats.Misses++
+		return nil, true
+	}
+	c.stats.Hits++
+	c.unlink(e)
+	c.pushFront(e)
+	return e, true
+}
+
+// enabled reports whether c has a capacity greater than zero.
+func (c *Cache) enabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.capacity > 0
+}
+
+// add adds e to c unless c already holds its text, evicting the least
+// recently used entry if c is full.
+func (c *Cache) add(e *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.capacity <= 0 || c.entries[e.opts][e.text] != nil {
+		return
+	}
+	if c.entries == nil {
+		// c is the zero value or has been cleared
+		c.entries = make(map[cacheOptions]map[string]*cacheEntry)
+		c.root.prev, c.root.next = &c.root, &c.root
+	}
+	if c.len >= c.capacity {
+		c.remove(c.root.prev)
+		c.stats.Evictions++
+	}
+	m := c.entries[e.opts]
+	if m == nil {
+		m = make(map[string]*cacheEntry)
+		c.entries[e.opts] = m
+	}
+	m[e.text] = e
+	c.pushFront(e)
+	c.len++
+}
+
+// remove removes e from c.
+func (c *Cache) remove(e *cacheEntry) {
+	c.unlink(e)
+	m := c.entries[e.opts]
+	delete(m, e.text)
+	if len(m) == 0 {
+		delete(c.entries, e.opts)
+	}
+	c.len--
+}
+
+func (c *Cache) unlink(e *cacheEntry) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+}
+
+func (c *Cache) pushFront(e *cacheEntry) {
+	e.prev, e.next = &c.root, c.root.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// cacheOptions returns the options of o that are part of the key of a Cache.
+func (o *options) cacheOptions() cacheOptions {
+	return cacheOptions{o.level, o.defaultLevel, o.maxLength, o.maxBracketPairs, o.workBudget}
+}
+
+// paragraphEnd returns the length of the first paragraph of the text, which
+// is given as b or, if b is nil, as s, without and with its paragraph
+// separator. It finds the characters of class B without decoding the text:
+// U+000A, U+000D, U+001C to U+001E, U+0085 and U+2029. A CR LF sequence counts
+// as a single separator.
+func paragraphEnd(b []byte, s string) (end, n int) {
+	length := len(s)
+	if b != nil {
+		length = len(b)
+	}
+	at := func(i int) byte {
+		if i >= length {
+			return 0
+		}
+		if b != nil {
+			return b[i]
+		}
+		return s[i]
+	}
+	for i := 0; i < length; i++ {
+		switch c := at(i); {
+		case c == '\n' || c == 0x1C || c == 0x1D || c == 0x1E:
+			return i, i + 1
+		case c == '\r':
+			if at(i+1) == '\n' {
+				return i, i + 2
+			}
+			return i, i + 1
+		case c == 0xC2 && at(i+1) == 0x85:
+			return i, i + 2
+		case c == 0xE2 && at(i+1) == 0x80 && at(i+2) == 0xA9:
+			return i, i + 3
+		}
+	}
+	return length, length
+}
+
+// cloneString returns a copy of s that does not share memory with s, like
+// strings.Clone, which needs Go 1.20.
+func cloneString(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	b.WriteString(s)
+	return b.String()
+}
+
+// loadCached sets p to the text and results of e.
+func (p *Paragraph) loadCached(e *cacheEntry) {
+	p.runes = append(p.runes, e.runes...)
+	p.offsets = append(p.offsets, e.offsets...)
+	p.types = append(p.types, e.types...)
+	p.pairTypes = append(p.pairTypes, e.pairTypes...)
+	p.pairValues = append(p.pairValues, e.pairValues...)
+	p.classes = e.classes
+	p.levels = append(p.levels[:0], e.levels...)
+	p.embeddingLevel = e.embeddingLevel
+	p.fastPath = e.fastPath
+	if !e.fastPath {
+		if p.para == nil {
+			p.para = &paragraph{}
+		}
+		p.para.restore(e.types, e.resultLevels, e.embeddingLevel)
+	}
+	p.resolved = true
+}
+
+// addToCache adds the text and results of p to its cache if p has been set
+// to a text that was not in the cache.
+func (p *Paragraph) addToCache() {
+	if !p.cacheMiss {
+		return
+	}
+	if !p.options.cache.enabled() {
+		// The cache has been disabled since the text was looked up.
+		p.cacheMiss, p.cacheText = false, ""
+		return
+	}
+	e := &cacheEntry{
+		opts:           p.options.cacheOptions(),
+		text:           p.cacheText,
+		runes:          append([]rune(nil), p.runes...),
+		offsets:        append([]int(nil), p.offsets...),
+		types:          append([]Class(nil), p.types...),
+		pairTypes:      append([]bracketType(nil), p.pairTypes...),
+		pairValues:     append([]rune(nil), p.pairValues...),
+		classes:        p.classes,
+		levels:         append([]Level(nil), p.levels...),
+		embeddingLevel: p.embeddingLevel,
+		fastPath:       p.fastPath,
+	}
+	if !p.fastPath {
+		e.resultLevels = append([]Level(nil), p.para.resultLevels...)
+	}
+	p.cacheMiss, p.cacheText = false, ""
+	p.options.cache.add(e)
+}
@@ -0,0 +1,355 @@
+package sdbidi
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// The conformance tests read BidiTest.txt and BidiCharacterTest.txt of
+// UnicodeVersion from testdata/ucd/<version>. They are available from
+// https://www.unicode.org/Public/<version>/ucd/. The tests are skipped if
+// testdata has no directory for UnicodeVersion and fail if it lacks one of
+// the files.
+
+// knownConformanceFailures lists the test cases that are known to fail, keyed
+// by file, line and paragraph direction as in "BidiTest.txt:1234:RTL". The
+// value explains the failure. Known failures are reported, but do not fail
+// the test. All test cases of Unicode 15.0.0 pass.
+var knownConformanceFailures = map[string]string{}
+
+// A conformanceFailure describes a test case for which the resolved levels or
+// the visual order differ from the expected ones.
+type conformanceFailure struct {
+	file      string
+	line      int
+	direction string // auto, LTR or RTL
+	input     string
+	check     string // levels or order
+	got, want string
+	reason    string // the reason of a known failure
+}
+
+func (f conformanceFailure) key() string {
+	return fmt.Sprintf("%s:%d:%s", f.file, f.line, f.direction)
+}
+
+func (f conformanceFailure) String() string {
+	return fmt.Sprintf("%s: %s: %s: got %s, want %s", f.key(), f.input, f.check, f.got, f.want)
+}
+
+// conformanceReport collects the results of a conformance test.
+type conformanceReport struct {
+	file     string
+	cases    int
+	failures []conformanceFailure
+}
+
+func (r *conformanceReport) check(line int, direction, input, check, got, want string) {
+	if got == want {
+		return
+	}
+	f := conformanceFailure{r.file, line, direction, input, check, got, want, ""}
+	f.reason = knownConformanceFailures[f.key()]
+	r.failures = append(r.failures, f)
+}
+
+// report fails t for each unexpected failure and logs the known failures,
+// grouped by their reason.
+func (r *conformanceReport) report(t *testing.T) {
+	t.Helper()
+	failed := map[string]bool{}
+	known := map[string][]conformanceFailure{}
+	var reasons []string
+	for _, f := range r.failures {
+		failed[f.key()] = true
+		if f.reason == "" {
+			t.Errorf("%v", f)
+			continue
+		}
+		if known[f.reason] == nil {
+			reasons = append(reasons, f.reason)
+		}
+		known[f.reason] = append(known[f.reason], f)
+	}
+	for key := range knownConformanceFailures {
+		if strings.HasPrefix(key, r.file+":") && !failed[key] {
+			t.Errorf("%s: known failure passes, remove it from knownConformanceFailures", key)
+		}
+	}
+	for _, reason := range reasons {
+		t.Logf("%s: %d known failures: %s", r.file, len(known[reason]), reason)
+		for _, f := range known[reason] {
+			t.Logf("\t%v", f)
+		}
+	}
+	t.Logf("%s: %d test cases, %d failed checks", r.file, r.cases, len(r.failures))
+}
+
+func openConformanceFile(t *testing.T, name string) *os.File {
+	dir := filepath.Join("testdata", "ucd", UnicodeVersion)
+	if _, err := os.Stat(dir); err != nil {
+		t.Skipf("conformance test data for Unicode %s not found: %v", UnicodeVersion, err)
+	}
+	f, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// hasSeparator reports whether text contains a paragraph separator.
+func hasSeparator(text []rune) bool {
+	for _, r := range text {
+		if p, _ := LookupRune(r); p.Class() == B {
+			return true
+		}
+	}
+	return false
+}
+
+// classRunes holds a character of each Bidi class.
+var classRunes = map[string]rune{
+	"L":   'a',
+	"R":   0x05D0,
+	"EN":  '1',
+	"ES":  '+',
+	"ET":  '$',
+	"AN":  0x0660,
+	"CS":  ',',
+	"B":   0x2029,
+	"S":   '\t',
+	"WS":  ' ',
+	"ON":  '!',
+	"BN":  0x00AD,
+	"NSM": 0x0300,
+	"AL":  0x0627,
+	"LRO": 0x202D,
+	"RLO": 0x202E,
+	"LRE": 0x202A,
+	"RLE": 0x202B,
+	"PDF": 0x202C,
+	"LRI": 0x2066,
+	"RLI": 0x2067,
+	"FSI": 0x2068,
+	"PDI": 0x2069,
+}
+
+// removedByX9 reports whether the character r is removed by rule X9. Test
+// data lists no level and no visual position for these characters.
+func removedByX9(r rune) bool {
+	p, _ := LookupRune(r)
+	switch p.Class() {
+	case LRE, RLE, LRO, RLO, PDF, BN:
+		return true
+	}
+	return false
+}
+
+// resolveConformance resolves text as a single paragraph, which is a single
+// line, and returns the paragraph embedding level, the levels of all
+// characters and the visual order of the characters that are not removed by
+// rule X9.
+//
+// Text without a paragraph separator is resolved through Paragraph. In the
+// test data a paragraph separator does not end the paragraph: it terminates
+// all embeddings and isolates (rule X8) and gets the paragraph embedding level
+// (rule L1). Since Paragraph ends at the first separator, text with a
+// separator is resolved by the paragraph core directly.
+func resolveConformance(text []rune, opts ...Option) (paraLevel Level, levels []Level, order []int, err error) {
+	var visual []int
+	var p Paragraph
+	if hasSeparator(text) {
+		p.options.set(opts)
+		for i, r := range text {
+			props, _ := LookupRune(r)
+			p.appendRune(r, props, i)
+		}
+		var para paragraph
+		if levels, err = fullLevels(&para, &p); err != nil {
+			return 0, nil, nil, err
+		}
+		paraLevel = para.embeddingLevel
+		visual = computeReordering(levels)
+	} else {
+		if _, err = p.SetString(string(text), opts...); err != nil {
+			return 0, nil, nil, err
+		}
+		if levels, err = p.Levels(); err != nil {
+			return 0, nil, nil, err
+		}
+		if !p.IsLeftToRight() {
+			paraLevel = 1
+		}
+		o, err := p.Order()
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		visual = o.VisualToLogical()
+	}
+	for _, v := range visual {
+		if !removedByX9(text[v]) {
+			order = append(order, v)
+		}
+	}
+	return paraLevel, levels, order, nil
+}
+
+func formatLevels(text []rune, levels []Level) string {
+	s := make([]string, len(levels))
+	for i, l := range levels {
+		if removedByX9(text[i]) {
+			s[i] = "x"
+		} else {
+			s[i] = strconv.Itoa(int(l))
+		}
+	}
+	return strings.Join(s, " ")
+}
+
+func formatOrder(order []int) string {
+	s := make([]string, len(order))
+	for i, v := range order {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, " ")
+}
+
+var conformanceDirections = []struct {
+	name string
+	opts []Option
+}{
+	{"auto", nil},
+	{"LTR", []Option{ForceDirection(LeftToRight)}},
+	{"RTL", []Option{ForceDirection(RightToLeft)}},
+}
+
+// TestBidiTest runs the test cases of BidiTest.txt. Each line lists a sequence
+// of Bidi classes and a bitset of paragraph directions: 1 for auto, 2 for LTR
+// and 4 for RTL. The expected levels and visual order are given by the
+// preceding @Levels and @Reorder lines.
+func TestBidiTest(t *testing.T) {
+	f := openConformanceFile(t, "BidiTest.txt")
+	defer f.Close()
+
+	r := conformanceReport{file: "BidiTest.txt"}
+	var levels, reorder string
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		text = strings.TrimSpace(text)
+		switch {
+		case text == "":
+			continue
+		case strings.HasPrefix(text, "@Levels:"):
+			levels = strings.TrimSpace(text[len("@Levels:"):])
+			continue
+		case strings.HasPrefix(text, "@Reorder:"):
+			reorder = strings.TrimSpace(text[len("@Reorder:"):])
+			continue
+		case strings.HasPrefix(text, "@"):
+			continue
+		}
+
+		fields := strings.Split(text, ";")
+		if len(fields) != 2 {
+			t.Fatalf("BidiTest.txt:%d: invalid line %q", line, text)
+		}
+		var input []rune
+		for _, c := range strings.Fields(fields[0]) {
+			r, ok := classRunes[c]
+			if !ok {
+				t.Fatalf("BidiTest.txt:%d: unknown class %q", line, c)
+			}
+			input = append(input, r)
+		}
+		bitset, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if err != nil {
+			t.Fatalf("BidiTest.txt:%d: %v", line, err)
+		}
+		for i, dir := range conformanceDirections {
+			if bitset&(1<<uint(i)) == 0 {
+				continue
+			}
+			r.cases++
+			_, gotLevels, gotOrder, err := resolveConformance(input, dir.opts...)
+			if err != nil {
+				r.check(line, dir.name, fields[0], "error", err.Error(), "")
+				continue
+			}
+			r.check(line, dir.name, fields[0], "levels", formatLevels(input, gotLevels), levels)
+			r.check(line, dir.name, fields[0], "order", formatOrder(gotOrder), reorder)
+		}
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	r.report(t)
+}
+
+// TestBidiCharacterTest runs the test cases of BidiCharacterTest.txt. Each
+// line lists the code points, the paragraph direction (0 for LTR, 1 for RTL
+// and 2 for auto), the resolved paragraph embedding level, the resolved
+// levels and the visual order.
+func TestBidiCharacterTest(t *testing.T) {
+	f := openConformanceFile(t, "BidiCharacterTest.txt")
+	defer f.Close()
+
+	r := conformanceReport{file: "BidiCharacterTest.txt"}
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 1<<20)
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		fields := strings.Split(text, ";")
+		if len(fields) != 5 {
+			t.Fatalf("BidiCharacterTest.txt:%d: invalid line %q", line, text)
+		}
+		var input []rune
+		for _, c := range strings.Fields(fields[0]) {
+			r, err := strconv.ParseUint(c, 16, 32)
+			if err != nil {
+				t.Fatalf("BidiCharacterTest.txt:%d: %v", line, err)
+			}
+			input = append(input, rune(r))
+		}
+		var dir int
+		switch strings.TrimSpace(fields[1]) {
+		case "0":
+			dir = 1
+		case "1":
+			dir = 2
+		case "2":
+			dir = 0
+		default:
+			t.Fatalf("BidiCharacterTest.txt:%d: invalid paragraph direction %q", line, fields[1])
+		}
+
+		r.cases++
+		name := conformanceDirections[dir].name
+		paraLevel, levels, order, err := resolveConformance(input, conformanceDirections[dir].opts...)
+		if err != nil {
+			r.check(line, name, fields[0], "error", err.Error(), "")
+			continue
+		}
+		r.check(line, name, fields[0], "paragraph level", strconv.Itoa(int(paraLevel)), strings.TrimSpace(fields[2]))
+		r.check(line, name, fields[0], "levels", formatLevels(input, levels), strings.Join(strings.Fields(fields[3]), " "))
+		r.check(line, name, fields[0], "order", formatOrder(order), strings.Join(strings.Fields(fields[4]), " "))
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	r.report(t)
+}
@@ -4,10 +4,7 @@
 
 package sdbidi
 
-import (
-	"fmt"
-	"log"
-)
+import "fmt"
 
 // This implementation is a port based on the reference implementation found at:
 // https://www.unicode.org/Public/PROGRAMS/BidiReferenceJava/
@@ -50,12 +47,12 @@ import (
 // base character in RTL runs) and that it adjusts the glyphs used to render
 // mirrored characters that are in RTL runs so that they render appropriately.
 
-// level is the embedding level of a character. Even embedding levels indicate
+// Level is the embedding level of a character. Even embedding levels indicate
 // left-to-right order and odd levels indicate right-to-left order. The special
 // level of -1 is reserved for undefined order.
-type level int8
+type Level int8
 
-const implicitLevel level = -1
+const implicitLevel Level = -1
 
 // in returns if x is equal to any of the values in set.
 func (c Class) in(set ...Class) bool {
@@ -75,11 +72,19 @@ type paragraph struct {
 	pairTypes  []bracketType // paired Bracket types for paragraph
 	pairValues []rune        // rune for opening bracket or pbOpen and pbClose; 0 for pbNone
 
-	embeddingLevel level // default: = implicitLevel;
+	embeddingLevel Level // default: = implicitLevel;
+
+	// defaultLevel is the paragraph embedding level used if embeddingLevel
+	// is implicitLevel and the paragraph has no strong character (rule HL1).
+	defaultLevel Level
+
+	// explicitLevels holds externally supplied embedding levels that replace
+	// rules X1-X8, or nil.
+	explicitLevels []Level
 
 	// at the paragraph levels
 	resultTypes  []Class
-	resultLevels []level
+	resultLevels []Level
 
 	// Index of matching PDI for isolate initiator characters. For other
 	// characters, the value of matchingPDI will be set to -1. For isolate
@@ -91,50 +96,266 @@ type paragraph struct {
 	// characters, and for PDIs with no matching isolate initiator, the value of
 	// matchingIsolateInitiator will be set to -1.
 	matchingIsolateInitiator []int
+
+	// maxBracketPairs is the maximum number of bracket pairs resolved by rule
+	// N0, or negative if there is no limit. bracketPairsLeft counts the pairs
+	// that may still be resolved during a run of the algorithm. The limits of
+	// maxBracketPairs and work are set before calling reset.
+	maxBracketPairs  int
+	bracketPairsLeft int
+
+	// work accounts for the steps taken by a run of the algorithm.
+	work workBudget
+
+	// keepRuns is set if the results of each run are kept in prev, so that
+	// the next run can reuse them after an edit of the text.
+	keepRuns bool
+	prev     previousRun
+
+	// The following fields hold memory that is reused by each run of the
+	// algorithm.
+
+	// levelRuns holds the indexes of the characters not removed by rule X9,
+	// grouped into level runs. Level run i ends at levelRunLimits[i].
+	levelRuns      []int
+	levelRunLimits []int
+
+	// runForCharacter holds the level run of each character.
+	runForCharacter []int
+
+	// isolateStack is used by determineMatchingIsolates.
+	isolateStack []int
+
+	// sequences holds the isolating run sequences. Their indexes, types and
+	// resolvedLevels are consecutive parts of sequenceIndexes, sequenceTypes
+	// and sequenceLevels.
+	sequences       []isolatingRunSequence
+	sequenceIndexes []int
+	sequenceTypes   []Class
+	sequenceLevels  []Level
+
+	// openers and pairPositions are used by the bracketPairer.
+	openers       []int
+	pairPositions bracketPairs
+
+	// sequenceInput holds the types of the isolating run sequences before
+	// rule W1 if keepRuns is set.
+	sequenceInput []Class
 }
 
-// newParagraph initializes a paragraph. The user needs to supply a few arrays
+// reset initializes a paragraph. The user needs to supply a few arrays
 // corresponding to the preprocessed text input. The types correspond to the
 // Unicode BiDi classes for each rune. pairTypes indicates the bracket type for
 // each rune. pairValues provides a unique bracket class identifier for each
 // rune (suggested is the rune of the open bracket for opening and matching
 // close brackets, after normalization). The embedding levels are optional, but
-// may be supplied to encode embedding levels of styled text.
+// may be supplied to encode embedding levels of styled text. The defaultLevel
+// replaces the level 0 prescribed by rule P3 for paragraphs without strong
+// characters. The explicitLevels, if not nil, hold an embedding level for each
+// character that is used instead of the levels computed from explicit
+// formatting characters.
 //
-// TODO: return an error.
-func newParagraph(types []Class, pairTypes []bracketType, pairValues []rune, levels level) (*paragraph, error) {
+// The memory allocated by a previous call of reset is reused.
+func (p *paragraph) reset(types []Class, pairTypes []bracketType, pairValues []rune, levels, defaultLevel Level, explicitLevels []Level) error {
 	var err error
 	if err = validateTypes(types); err != nil {
-		return nil, err
+		return err
 	}
 	if err = validatePbTypes(pairTypes); err != nil {
-		return nil, err
+		return err
 	}
 	if err = validatePbValues(pairValues, pairTypes); err != nil {
-		return nil, err
+		return err
 	}
 	if err = validateParagraphEmbeddingLevel(levels); err != nil {
-		return nil, err
+		return err
+	}
+	if defaultLevel != 0 && defaultLevel != 1 {
+		return fmt.Errorf("%w: illegal default paragraph embedding level %d", ErrInvalidLevel, defaultLevel)
+	}
+	if err = validateExplicitLevels(explicitLevels, types); err != nil {
+		return err
 	}
 
-	p := &paragraph{
-		initialTypes:   append([]Class(nil), types...),
-		embeddingLevel: levels,
+	p.initialTypes = append(p.initialTypes[:0], types...)
+	p.embeddingLevel = levels
+	p.defaultLevel = defaultLevel
+	p.explicitLevels = explicitLevels
 
-		pairTypes:  pairTypes,
-		pairValues: pairValues,
+	p.pairTypes = pairTypes
+	p.pairValues = pairValues
 
-		resultTypes: append([]Class(nil), types...),
-	}
-	p.run()
-	return p, nil
+	p.resultTypes = append(p.resultTypes[:0], types...)
+	return p.run()
 }
 
 func (p *paragraph) Len() int { return len(p.initialTypes) }
 
+// A workBudget limits the number of steps taken by the algorithm.
+type workBudget struct {
+	limit int // the maximum number of steps, or negative if there is no limit
+	steps int // the number of steps taken
+}
+
+// spend takes n steps. It returns a *LimitError if the steps taken exceed the
+// limit.
+func (w *workBudget) spend(n int) error {
+	w.steps += n
+	if w.limit >= 0 && w.steps > w.limit {
+		return &LimitError{Limit: "WorkBudget", Max: w.limit}
+	}
+	return nil
+}
+
+// A previousRun holds the results of a run of the algorithm, so that the next
+// run for a text that differs by an edit can reuse the results of the
+// isolating run sequences that the edit does not affect. Rules W1-I2 resolve
+// an isolating run sequence based only on its characters, their types before
+// rule W1, its level, sos and eos, so their results are reused if these are
+// the same.
+type previousRun struct {
+	// valid is set if the other fields hold the results of a run for the
+	// text that became the current text by replacing the runes from
+	// editStart to editEnd by editLen others.
+	valid                       bool
+	editStart, editEnd, editLen int
+
+	sequences []previousSequence
+
+	// indexes and types hold the indexes of the characters of the
+	// sequences and their types before rule W1.
+	indexes []int
+	types   []Class
+
+	// sequenceOf holds the sequence of each character, or -1 for characters
+	// removed by rule X9.
+	sequenceOf []int
+
+	resultTypes  []Class
+	resultLevels []Level
+}
+
+// A previousSequence describes an isolating run sequence of a previousRun.
+// Its characters are indexes[start:end].
+type previousSequence struct {
+	start, end int
+	level      Level
+	sos, eos   Class
+}
+
+// edit records that the runes from start to end of the text have been
+// replaced by n others. Edits since the previous run are merged into a single
+// one that covers all of them.
+func (r *previousRun) edit(start, end, n int) {
+	if r.editStart == r.editEnd && r.editLen == 0 {
+		r.editStart, r.editEnd, r.editLen = start, end, n
+		return
+	}
+	// the range of the current text that covers both edits
+	lo, hi := start, end
+	if r.editStart < lo {
+		lo = r.editStart
+	}
+	if limit := r.editStart + r.editLen; limit > hi {
+		hi = limit
+	}
+	r.editEnd = hi - r.editLen + r.editEnd - r.editStart
+	r.editStart = lo
+	r.editLen = hi - lo - (end - start) + n
+}
+
+// previousIndex returns the index of the character at index i of the current
+// text in the text of the previous run, or -1 if the character was inserted
+// by an edit.
+func (r *previousRun) previousIndex(i int) int {
+	switch {
+	case i < r.editStart:
+		return i
+	case i >= r.editStart+r.editLen:
+		return i - r.editLen + r.editEnd - r.editStart
+	}
+	return -1
+}
+
+// reuse copies the results of s from the previous run if s has the same input
+// as one of its sequences and reports whether it did so.
+func (p *paragraph) reuse(s *isolatingRunSequence) bool {
+	r := &p.prev
+	first := r.previousIndex(s.indexes[0])
+	if first < 0 || r.sequenceOf[first] < 0 {
+		return false
+	}
+	prev := r.sequences[r.sequenceOf[first]]
+	if prev.end-prev.start != len(s.indexes) || prev.level != s.level || prev.sos != s.sos || prev.eos != s.eos {
+		return false
+	}
+	for i, x := range s.indexes {
+		if r.previousIndex(x) != r.indexes[prev.start+i] || s.types[i] != r.types[prev.start+i] {
+			return false
+		}
+	}
+	for _, x := range s.indexes {
+		px := r.previousIndex(x)
+		p.resultTypes[x] = r.resultTypes[px]
+		p.resultLevels[x] = r.resultLevels[px]
+	}
+	return true
+}
+
+// keepResults stores the results of the run in p.prev.
+func (p *paragraph) keepResults() {
+	r := &p.prev
+	r.valid = true
+	r.editStart, r.editEnd, r.editLen = 0, 0, 0
+	r.sequences = r.sequences[:0]
+	r.sequenceOf = resizeInts(r.sequenceOf, p.Len())
+	for i := range r.sequenceOf {
+		r.sequenceOf[i] = -1
+	}
+	start := 0
+	for i, s := range p.sequences {
+		r.sequences = append(r.sequences, previousSequence{start, start + len(s.indexes), s.level, s.sos, s.eos})
+		for _, x := range s.indexes {
+			r.sequenceOf[x] = i
+		}
+		start += len(s.indexes)
+	}
+	r.indexes = append(r.indexes[:0], p.sequenceIndexes...)
+	r.types, p.sequenceInput = p.sequenceInput, r.types
+	r.resultTypes = append(r.resultTypes[:0], p.resultTypes...)
+	r.resultLevels = append(r.resultLevels[:0], p.resultLevels...)
+}
+
+// restore sets p to the state after a run for the given types that resulted
+// in the given levels and paragraph embedding level, as far as needed by
+// getLevels.
+func (p *paragraph) restore(types []Class, resultLevels []Level, embeddingLevel Level) {
+	p.initialTypes = append(p.initialTypes[:0], types...)
+	p.resultLevels = append(p.resultLevels[:0], resultLevels...)
+	p.embeddingLevel = embeddingLevel
+	p.prev.valid = false
+}
+
 // The algorithm. Does not include line-based processing (Rules L1, L2).
 // These are applied later in the line-based phase of the algorithm.
-func (p *paragraph) run() {
+func (p *paragraph) run() error {
+	p.bracketPairsLeft = p.maxBracketPairs
+
+	// Rules BD9, P2-P3, X1-X8 and X10 take a step per character each.
+	if err := p.work.spend(4 * p.Len()); err != nil {
+		return err
+	}
+	if p.explicitLevels != nil {
+		// Explicit formatting characters are ignored if the embedding
+		// levels are supplied externally.
+		for i, t := range p.initialTypes {
+			if t.in(LRE, RLE, LRO, RLO, PDF, LRI, RLI, FSI, PDI) {
+				p.initialTypes[i] = BN
+				p.resultTypes[i] = BN
+			}
+		}
+	}
+
 	p.determineMatchingIsolates()
 
 	// 1) determining the paragraph level
@@ -142,11 +363,11 @@ func (p *paragraph) run() {
 	// Rules P2, P3.
 	// If no externally supplied paragraph embedding level, use default.
 	if p.embeddingLevel == implicitLevel {
-		p.embeddingLevel = p.determineParagraphEmbeddingLevel(0, p.Len())
+		p.embeddingLevel = p.determineParagraphEmbeddingLevel(0, p.Len(), p.defaultLevel)
 	}
 
 	// Initialize result levels to paragraph embedding level.
-	p.resultLevels = make([]level, p.Len())
+	p.resultLevels = resizeLevels(p.resultLevels, p.Len())
 	setLevels(p.resultLevels, p.embeddingLevel)
 
 	// 2) Explicit levels and directions
@@ -161,22 +382,48 @@ func (p *paragraph) run() {
 
 	// Rule X10.
 	// Run remainder of algorithm one isolating run sequence at a time
-	for _, seq := range p.determineIsolatingRunSequences() {
+	p.determineIsolatingRunSequences()
+	if p.keepRuns {
+		p.sequenceInput = append(p.sequenceInput[:0], p.sequenceTypes...)
+	}
+	// Reusing sequences would change which bracket pairs are resolved if
+	// their number is limited.
+	reuse := p.keepRuns && p.prev.valid && p.maxBracketPairs < 0
+	for i := range p.sequences {
+		seq := &p.sequences[i]
+		if reuse && p.reuse(seq) {
+			continue
+		}
+
+		// Rules W1-W7, N1-N2 and I1-I2 take a step per character each,
+		// rule N0 accounts for its steps itself.
+		if err := p.work.spend(3 * len(seq.indexes)); err != nil {
+			return err
+		}
+
 		// 3) resolving weak types
 		// Rules W1-W7.
-		seq.resolveWeakTypes()
+		if err := seq.resolveWeakTypes(); err != nil {
+			return err
+		}
 
 		// 4a) resolving paired brackets
 		// Rule N0
-		resolvePairedBrackets(seq)
+		if err := resolvePairedBrackets(seq); err != nil {
+			return err
+		}
 
 		// 4b) resolving neutral types
 		// Rules N1-N3.
-		seq.resolveNeutralTypes()
+		if err := seq.resolveNeutralTypes(); err != nil {
+			return err
+		}
 
 		// 5) resolving implicit embedding levels
 		// Rules I1, I2.
-		seq.resolveImplicitLevels()
+		if err := seq.resolveImplicitLevels(); err != nil {
+			return err
+		}
 
 		// Apply the computed levels and types
 		seq.applyLevelsAndTypes()
@@ -186,6 +433,12 @@ func (p *paragraph) run() {
 	// BNs. This is for convenience, so the resulting level array will have
 	// a value for every character.
 	p.assignLevelsToCharactersRemovedByX9()
+	if p.keepRuns {
+		p.keepResults()
+	} else {
+		p.prev.valid = false
+	}
+	return nil
 }
 
 // determineMatchingIsolates determines the matching PDI for each isolate
@@ -204,42 +457,43 @@ func (p *paragraph) run() {
 //    If there is no matching isolate initiator, or the character is not a PDI,
 //    it is set to -1.
 func (p *paragraph) determineMatchingIsolates() {
-	p.matchingPDI = make([]int, p.Len())
-	p.matchingIsolateInitiator = make([]int, p.Len())
+	p.matchingPDI = resizeInts(p.matchingPDI, p.Len())
+	p.matchingIsolateInitiator = resizeInts(p.matchingIsolateInitiator, p.Len())
 
 	for i := range p.matchingIsolateInitiator {
 		p.matchingIsolateInitiator[i] = -1
 	}
 
-	for i := range p.matchingPDI {
+	// The isolate initiators without a matching PDI so far are kept on a
+	// stack. A PDI matches the most recent one.
+	stack := p.isolateStack[:0]
+	for i, t := range p.resultTypes {
 		p.matchingPDI[i] = -1
 
-		if t := p.resultTypes[i]; t.in(LRI, RLI, FSI) {
-			depthCounter := 1
-			for j := i + 1; j < p.Len(); j++ {
-				if u := p.resultTypes[j]; u.in(LRI, RLI, FSI) {
-					depthCounter++
-				} else if u == PDI {
-					if depthCounter--; depthCounter == 0 {
-						p.matchingPDI[i] = j
-						p.matchingIsolateInitiator[j] = i
-						break
-					}
-				}
-			}
-			if p.matchingPDI[i] == -1 {
-				p.matchingPDI[i] = p.Len()
+		switch t {
+		case LRI, RLI, FSI:
+			stack = append(stack, i)
+		case PDI:
+			if n := len(stack); n > 0 {
+				p.matchingPDI[stack[n-1]] = i
+				p.matchingIsolateInitiator[i] = stack[n-1]
+				stack = stack[:n-1]
 			}
 		}
 	}
+	for _, i := range stack {
+		p.matchingPDI[i] = p.Len()
+	}
+	p.isolateStack = stack
 }
 
 // determineParagraphEmbeddingLevel reports the resolved paragraph direction of
-// the substring limited by the given range [start, end).
+// the substring limited by the given range [start, end). If the substring has
+// no strong character, defaultLevel is returned.
 //
 // Determines the paragraph level based on rules P2, P3. This is also used
 // in rule X5c to find if an FSI should resolve to LRI or RLI.
-func (p *paragraph) determineParagraphEmbeddingLevel(start, end int) level {
+func (p *paragraph) determineParagraphEmbeddingLevel(start, end int, defaultLevel Level) Level {
 	var strongType Class = unknownClass
 
 	// Rule P2.
@@ -248,17 +502,17 @@ func (p *paragraph) determineParagraphEmbeddingLevel(start, end int) level {
 			strongType = t
 			break
 		} else if t.in(FSI, LRI, RLI) {
-			i = p.matchingPDI[i] // skip over to the matching PDI
-			if i > end {
-				log.Panic("assert (i <= end)")
-			}
+			// Skip over to the matching PDI. An isolate without one
+			// extends to the end of the text, which ends the loop.
+			i = p.matchingPDI[i]
 		}
 	}
 	// Rule P3.
 	switch strongType {
 	case unknownClass: // none found
-		// default embedding level when no strong types found is 0.
-		return 0
+		// default embedding level when no strong types found is 0, unless
+		// overridden by rule HL1.
+		return defaultLevel
 	case L:
 		return 0
 	default: // AL, R
@@ -272,7 +526,7 @@ const maxDepth = 125
 // statuses
 type directionalStatusStack struct {
 	stackCounter        int
-	embeddingLevelStack [maxDepth + 1]level
+	embeddingLevelStack [maxDepth + 1]Level
 	overrideStatusStack [maxDepth + 1]Class
 	isolateStatusStack  [maxDepth + 1]bool
 }
@@ -281,14 +535,14 @@ func (s *directionalStatusStack) empty()     { s.stackCounter = 0 }
 func (s *directionalStatusStack) pop()       { s.stackCounter-- }
 func (s *directionalStatusStack) depth() int { return s.stackCounter }
 
-func (s *directionalStatusStack) push(level level, overrideStatus Class, isolateStatus bool) {
+func (s *directionalStatusStack) push(level Level, overrideStatus Class, isolateStatus bool) {
 	s.embeddingLevelStack[s.stackCounter] = level
 	s.overrideStatusStack[s.stackCounter] = overrideStatus
 	s.isolateStatusStack[s.stackCounter] = isolateStatus
 	s.stackCounter++
 }
 
-func (s *directionalStatusStack) lastEmbeddingLevel() level {
+func (s *directionalStatusStack) lastEmbeddingLevel() Level {
 	return s.embeddingLevelStack[s.stackCounter-1]
 }
 
@@ -302,6 +556,15 @@ func (s *directionalStatusStack) lastDirectionalIsolateStatus() bool {
 
 // Determine explicit levels using rules X1 - X8
 func (p *paragraph) determineExplicitEmbeddingLevels() {
+	if p.explicitLevels != nil {
+		// Externally supplied levels take the place of rules X1-X8. Levels
+		// below the paragraph embedding level are raised to it.
+		for i, lvl := range p.explicitLevels {
+			p.resultLevels[i] = maxLevel(lvl, p.embeddingLevel)
+		}
+		return
+	}
+
 	var stack directionalStatusStack
 	var overflowIsolateCount, overflowEmbeddingCount, validIsolateCount int
 
@@ -317,7 +580,7 @@ func (p *paragraph) determineExplicitEmbeddingLevels() {
 
 			// override if this is an FSI that resolves to RLI
 			if t == FSI {
-				isRTL = (p.determineParagraphEmbeddingLevel(i+1, p.matchingPDI[i]) == 1)
+				isRTL = (p.determineParagraphEmbeddingLevel(i+1, p.matchingPDI[i], 0) == 1)
 			}
 			if isIsolate {
 				p.resultLevels[i] = stack.lastEmbeddingLevel()
@@ -326,7 +589,7 @@ func (p *paragraph) determineExplicitEmbeddingLevels() {
 				}
 			}
 
-			var newLevel level
+			var newLevel Level
 			if isRTL {
 				// least greater odd
 				newLevel = (stack.lastEmbeddingLevel() + 1) | 1
@@ -422,14 +685,14 @@ type isolatingRunSequence struct {
 	indexes []int // indexes to the original string
 
 	types          []Class // type of each character using the index
-	resolvedLevels []level // resolved levels after application of rules
-	level          level
+	resolvedLevels []Level // resolved levels after application of rules
+	level          Level
 	sos, eos       Class
 }
 
 func (i *isolatingRunSequence) Len() int { return len(i.indexes) }
 
-func maxLevel(a, b level) level {
+func maxLevel(a, b Level) Level {
 	if a > b {
 		return a
 	}
@@ -438,12 +701,18 @@ func maxLevel(a, b level) level {
 
 // Rule X10, second bullet: Determine the start-of-sequence (sos) and end-of-sequence (eos) types,
 // 			 either L or R, for each isolating run sequence.
-func (p *paragraph) isolatingRunSequence(indexes []int) *isolatingRunSequence {
+//
+// The indexes must be the last part of p.sequenceIndexes. The types and
+// resolvedLevels of the sequence are appended to p.sequenceTypes and
+// p.sequenceLevels.
+func (p *paragraph) isolatingRunSequence(indexes []int) isolatingRunSequence {
 	length := len(indexes)
-	types := make([]Class, length)
-	for i, x := range indexes {
-		types[i] = p.resultTypes[x]
+	start := len(p.sequenceTypes)
+	for _, x := range indexes {
+		p.sequenceTypes = append(p.sequenceTypes, p.resultTypes[x])
 	}
+	types := p.sequenceTypes[start:]
+	p.sequenceLevels = p.sequenceLevels[:start+length]
 
 	// assign level, sos and eos
 	prevChar := indexes[0] - 1
@@ -455,7 +724,7 @@ func (p *paragraph) isolatingRunSequence(indexes []int) *isolatingRunSequence {
 		prevLevel = p.resultLevels[prevChar]
 	}
 
-	var succLevel level
+	var succLevel Level
 	lastType := types[length-1]
 	if lastType.in(LRI, RLI, FSI) {
 		succLevel = p.embeddingLevel
@@ -471,13 +740,14 @@ func (p *paragraph) isolatingRunSequence(indexes []int) *isolatingRunSequence {
 		}
 	}
 	level := p.resultLevels[indexes[0]]
-	return &isolatingRunSequence{
-		p:       p,
-		indexes: indexes,
-		types:   types,
-		level:   level,
-		sos:     typeForLevel(maxLevel(prevLevel, level)),
-		eos:     typeForLevel(maxLevel(succLevel, level)),
+	return isolatingRunSequence{
+		p:              p,
+		indexes:        indexes,
+		types:          types,
+		resolvedLevels: p.sequenceLevels[start:],
+		level:          level,
+		sos:            typeForLevel(maxLevel(prevLevel, level)),
+		eos:            typeForLevel(maxLevel(succLevel, level)),
 	}
 }
 
@@ -485,10 +755,12 @@ func (p *paragraph) isolatingRunSequence(indexes []int) *isolatingRunSequence {
 //
 // Note that some weak types (EN, AN) remain after this processing is
 // complete.
-func (s *isolatingRunSequence) resolveWeakTypes() {
+func (s *isolatingRunSequence) resolveWeakTypes() error {
 
 	// on entry, only these types remain
-	s.assertOnly(L, R, AL, EN, ES, ET, AN, CS, B, S, WS, ON, NSM, LRI, RLI, FSI, PDI)
+	if err := s.assertOnly(L, R, AL, EN, ES, ET, AN, CS, B, S, WS, ON, NSM, LRI, RLI, FSI, PDI); err != nil {
+		return err
+	}
 
 	// Rule W1.
 	// Changes all NSMs.
@@ -506,15 +778,14 @@ func (s *isolatingRunSequence) resolveWeakTypes() {
 
 	// Rule W2.
 	// EN does not change at the start of the run, because sos != AL.
+	prevStrongType := s.sos
 	for i, t := range s.types {
-		if t == EN {
-			for j := i - 1; j >= 0; j-- {
-				if t := s.types[j]; t.in(L, R, AL) {
-					if t == AL {
-						s.types[i] = AN
-					}
-					break
-				}
+		switch t {
+		case L, R, AL:
+			prevStrongType = t
+		case EN:
+			if prevStrongType == AL {
+				s.types[i] = AN
 			}
 		}
 	}
@@ -553,8 +824,8 @@ func (s *isolatingRunSequence) resolveWeakTypes() {
 	}
 
 	// Rule W5.
-	for i, t := range s.types {
-		if t == ET {
+	for i := 0; i < len(s.types); i++ {
+		if s.types[i] == ET {
 			// locate end of sequence
 			runStart := i
 			runEnd := s.findRunLimit(runStart, ET)
@@ -586,32 +857,31 @@ func (s *isolatingRunSequence) resolveWeakTypes() {
 	}
 
 	// Rule W7.
+	// The default at the start of the run is sos.
+	prevStrongType = s.sos
 	for i, t := range s.types {
-		if t == EN {
-			// set default if we reach start of run
-			prevStrongType := s.sos
-			for j := i - 1; j >= 0; j-- {
-				t = s.types[j]
-				if t == L || t == R { // AL's have been changed to R
-					prevStrongType = t
-					break
-				}
-			}
+		switch t {
+		case L, R: // AL's have been changed to R
+			prevStrongType = t
+		case EN:
 			if prevStrongType == L {
 				s.types[i] = L
 			}
 		}
 	}
+	return nil
 }
 
 // 6) resolving neutral types Rules N1-N2.
-func (s *isolatingRunSequence) resolveNeutralTypes() {
+func (s *isolatingRunSequence) resolveNeutralTypes() error {
 
 	// on entry, only these types can be in resultTypes
-	s.assertOnly(L, R, EN, AN, B, S, WS, ON, RLI, LRI, FSI, PDI)
+	if err := s.assertOnly(L, R, EN, AN, B, S, WS, ON, RLI, LRI, FSI, PDI); err != nil {
+		return err
+	}
 
-	for i, t := range s.types {
-		switch t {
+	for i := 0; i < len(s.types); i++ {
+		switch s.types[i] {
 		case WS, ON, B, S, RLI, LRI, FSI, PDI:
 			// find bounds of run of neutrals
 			runStart := i
@@ -656,9 +926,10 @@ func (s *isolatingRunSequence) resolveNeutralTypes() {
 			i = runEnd
 		}
 	}
+	return nil
 }
 
-func setLevels(levels []level, newLevel level) {
+func setLevels(levels []Level, newLevel Level) {
 	for i := range levels {
 		levels[i] = newLevel
 	}
@@ -671,12 +942,13 @@ func setTypes(types []Class, newType Class) {
 }
 
 // 7) resolving implicit embedding levels Rules I1, I2.
-func (s *isolatingRunSequence) resolveImplicitLevels() {
+func (s *isolatingRunSequence) resolveImplicitLevels() error {
 
 	// on entry, only these types can be in resultTypes
-	s.assertOnly(L, R, EN, AN)
+	if err := s.assertOnly(L, R, EN, AN); err != nil {
+		return err
+	}
 
-	s.resolvedLevels = make([]level, len(s.types))
 	setLevels(s.resolvedLevels, s.level)
 
 	if (s.level & 1) == 0 { // even level
@@ -700,6 +972,7 @@ func (s *isolatingRunSequence) resolveImplicitLevels() {
 			}
 		}
 	}
+	return nil
 }
 
 // Applies the levels and types resolved in rules W1-I2 to the
@@ -729,8 +1002,8 @@ loop:
 }
 
 // Algorithm validation. Assert that all values in types are in the
-// provided set.
-func (s *isolatingRunSequence) assertOnly(codes ...Class) {
+// provided set. The returned error wraps ErrInternal.
+func (s *isolatingRunSequence) assertOnly(codes ...Class) error {
 loop:
 	for i, t := range s.types {
 		for _, c := range codes {
@@ -738,19 +1011,21 @@ loop:
 				continue loop
 			}
 		}
-		log.Panicf("invalid bidi code %v present in assertOnly at position %d", t, s.indexes[i])
+		return fmt.Errorf("%w: invalid bidi code %v present in assertOnly at position %d", ErrInternal, t, s.indexes[i])
 	}
+	return nil
 }
 
-// determineLevelRuns returns an array of level runs. Each level run is
-// described as an array of indexes into the input string.
+// determineLevelRuns determines the level runs and stores them in
+// p.levelRuns and p.levelRunLimits. Each level run is described as a slice of
+// indexes into the input string.
 //
 // Determines the level runs. Rule X9 will be applied in determining the
 // runs, in the way that makes sure the characters that are supposed to be
 // removed are not included in the runs.
-func (p *paragraph) determineLevelRuns() [][]int {
-	run := []int{}
-	allRuns := [][]int{}
+func (p *paragraph) determineLevelRuns() {
+	p.levelRuns = p.levelRuns[:0]
+	p.levelRunLimits = p.levelRunLimits[:0]
 	currentLevel := implicitLevel
 
 	for i := range p.initialTypes {
@@ -758,59 +1033,70 @@ func (p *paragraph) determineLevelRuns() [][]int {
 			if p.resultLevels[i] != currentLevel {
 				// we just encountered a new run; wrap up last run
 				if currentLevel >= 0 { // only wrap it up if there was a run
-					allRuns = append(allRuns, run)
-					run = nil
+					p.levelRunLimits = append(p.levelRunLimits, len(p.levelRuns))
 				}
 				// Start new run
 				currentLevel = p.resultLevels[i]
 			}
-			run = append(run, i)
+			p.levelRuns = append(p.levelRuns, i)
 		}
 	}
 	// Wrap up the final run, if any
-	if len(run) > 0 {
-		allRuns = append(allRuns, run)
+	if currentLevel >= 0 {
+		p.levelRunLimits = append(p.levelRunLimits, len(p.levelRuns))
+	}
+}
+
+// levelRun returns the indexes of the ith level run.
+func (p *paragraph) levelRun(i int) []int {
+	start := 0
+	if i > 0 {
+		start = p.levelRunLimits[i-1]
 	}
-	return allRuns
+	return p.levelRuns[start:p.levelRunLimits[i]]
 }
 
-// Definition BD13. Determine isolating run sequences.
-func (p *paragraph) determineIsolatingRunSequences() []*isolatingRunSequence {
-	levelRuns := p.determineLevelRuns()
+// Definition BD13. Determine isolating run sequences and store them in
+// p.sequences.
+func (p *paragraph) determineIsolatingRunSequences() {
+	p.determineLevelRuns()
 
 	// Compute the run that each character belongs to
-	runForCharacter := make([]int, p.Len())
-	for i, run := range levelRuns {
-		for _, index := range run {
-			runForCharacter[index] = i
+	p.runForCharacter = resizeInts(p.runForCharacter, p.Len())
+	for i := range p.levelRunLimits {
+		for _, index := range p.levelRun(i) {
+			p.runForCharacter[index] = i
 		}
 	}
 
-	sequences := []*isolatingRunSequence{}
-
-	var currentRunSequence []int
+	// Every character in a level run belongs to exactly one sequence, so
+	// the slices never need to grow while sequences refer to them.
+	n := len(p.levelRuns)
+	p.sequences = p.sequences[:0]
+	p.sequenceIndexes = resizeInts(p.sequenceIndexes, n)[:0]
+	p.sequenceTypes = resizeClasses(p.sequenceTypes, n)[:0]
+	p.sequenceLevels = resizeLevels(p.sequenceLevels, n)[:0]
 
-	for _, run := range levelRuns {
+	for i := range p.levelRunLimits {
+		run := p.levelRun(i)
 		first := run[0]
 		if p.initialTypes[first] != PDI || p.matchingIsolateInitiator[first] == -1 {
-			currentRunSequence = nil
-			// int run = i;
+			start := len(p.sequenceIndexes)
 			for {
-				// Copy this level run into currentRunSequence
-				currentRunSequence = append(currentRunSequence, run...)
+				// Copy this level run into the current sequence
+				p.sequenceIndexes = append(p.sequenceIndexes, run...)
 
-				last := currentRunSequence[len(currentRunSequence)-1]
+				last := p.sequenceIndexes[len(p.sequenceIndexes)-1]
 				lastT := p.initialTypes[last]
 				if lastT.in(LRI, RLI, FSI) && p.matchingPDI[last] != p.Len() {
-					run = levelRuns[runForCharacter[p.matchingPDI[last]]]
+					run = p.levelRun(p.runForCharacter[p.matchingPDI[last]])
 				} else {
 					break
 				}
 			}
-			sequences = append(sequences, p.isolatingRunSequence(currentRunSequence))
+			p.sequences = append(p.sequences, p.isolatingRunSequence(p.sequenceIndexes[start:]))
 		}
 	}
-	return sequences
 }
 
 // Assign level information to characters removed by rule X9. This is for
@@ -845,12 +1131,12 @@ func (p *paragraph) assignLevelsToCharactersRemovedByX9() {
 //
 
 // getLevels computes levels array breaking lines at offsets in linebreaks.
-// Rule L1.
+// Rule L1. The levels are stored in dst if it is large enough.
 //
 // The linebreaks array must include at least one value. The values must be
 // in strictly increasing order (no duplicates) between 1 and the length of
 // the text, inclusive. The last value must be the length of the text.
-func (p *paragraph) getLevels(linebreaks []int) []level {
+func (p *paragraph) getLevels(dst []Level, linebreaks []int) ([]Level, error) {
 	// Note that since the previous processing has removed all
 	// P, S, and WS values from resultTypes, the values referred to
 	// in these rules are the initial types, before any processing
@@ -862,9 +1148,11 @@ func (p *paragraph) getLevels(linebreaks []int) []level {
 	// These codes are treated like WS in this implementation,
 	// so they don't interrupt sequences of WS.
 
-	validateLineBreaks(linebreaks, p.Len())
+	if err := validateLineBreaks(linebreaks, p.Len()); err != nil {
+		return nil, err
+	}
 
-	result := append([]level(nil), p.resultLevels...)
+	result := append(dst[:0], p.resultLevels...)
 
 	// don't worry about linebreaks since if there is a break within
 	// a series of WS values preceding S, the linebreak itself
@@ -898,7 +1186,7 @@ func (p *paragraph) getLevels(linebreaks []int) []level {
 		start = limit
 	}
 
-	return result
+	return result, nil
 }
 
 // getReordering returns the reordering of lines from a visual index to a
@@ -916,20 +1204,22 @@ func (p *paragraph) getLevels(linebreaks []int) []level {
 // The linebreaks array must include at least one value. The values must be
 // in strictly increasing order (no duplicates) between 1 and the length of
 // the text, inclusive. The last value must be the length of the text.
-func (p *paragraph) getReordering(linebreaks []int) []int {
-	validateLineBreaks(linebreaks, p.Len())
-
-	return computeMultilineReordering(p.getLevels(linebreaks), linebreaks)
+func (p *paragraph) getReordering(linebreaks []int) ([]int, error) {
+	levels, err := p.getLevels(nil, linebreaks)
+	if err != nil {
+		return nil, err
+	}
+	return computeMultilineReordering(levels, linebreaks), nil
 }
 
 // Return multiline reordering array for a given level array. Reordering
 // does not occur across a line break.
-func computeMultilineReordering(levels []level, linebreaks []int) []int {
+func computeMultilineReordering(levels []Level, linebreaks []int) []int {
 	result := make([]int, len(levels))
 
 	start := 0
 	for _, limit := range linebreaks {
-		tempLevels := make([]level, limit-start)
+		tempLevels := make([]Level, limit-start)
 		copy(tempLevels, levels[start:])
 
 		for j, order := range computeReordering(tempLevels) {
@@ -943,47 +1233,143 @@ func computeMultilineReordering(levels []level, linebreaks []int) []int {
 // Return reordering array for a given level array. This reorders a single
 // line. The reordering is a visual to logical map. For example, the
 // leftmost char is string.charAt(order[0]). Rule L2.
-func computeReordering(levels []level) []int {
-	result := make([]int, len(levels))
-	// initialize order
-	for i := range result {
-		result[i] = i
+func computeReordering(levels []Level) []int {
+	var r reorderer
+	return r.reorder(levels)
+}
+
+// A reorderer implements rule L2 in time linear in the number of levels. The
+// memory of a reorderer is reused by each call of reorder.
+//
+// Rule L2 reverses, from the highest level down to the lowest odd level, any
+// contiguous sequence at that level or higher. Instead of doing so, reorder
+// builds a tree of these sequences: a node holds a sequence of the levels lo
+// and higher, which has the same extent for all levels from lo to hi. Its
+// children are the elements at level hi and the nodes of the sequences at
+// level hi+1 and higher. A node is reversed once for each level from lo to hi
+// that is at least the lowest odd level, so the visual order is given by a
+// depth-first traversal that visits the children of a node in reverse order
+// if the node and its ancestors are reversed an odd number of times.
+type reorderer struct {
+	nodes []l2Node
+	stack []int // the nodes that may get more children
+	order []int
+}
+
+// An l2Node is a node of the tree built by a reorderer. Its children are a
+// doubly linked list of nodes.
+type l2Node struct {
+	lo, hi      Level
+	index       int // the index of the element for a leaf, -1 otherwise
+	first, last int // the first and last child, -1 if none
+	prev, next  int // the siblings, -1 if none
+}
+
+// newNode adds a node without children and returns its index.
+func (r *reorderer) newNode(lo, hi Level, index int) int {
+	r.nodes = append(r.nodes, l2Node{lo: lo, hi: hi, index: index, first: -1, last: -1, prev: -1, next: -1})
+	return len(r.nodes) - 1
+}
+
+// appendChild adds the node child as the last child of parent.
+func (r *reorderer) appendChild(parent, child int) {
+	if last := r.nodes[parent].last; last >= 0 {
+		r.nodes[last].next = child
+		r.nodes[child].prev = last
+	} else {
+		r.nodes[parent].first = child
 	}
+	r.nodes[parent].last = child
+}
+
+// reorder returns the visual to logical map of elements with the given
+// levels. The returned slice is reused by the next call of reorder.
+func (r *reorderer) reorder(levels []Level) []int {
+	r.nodes = r.nodes[:0]
+	r.stack = append(r.stack[:0], r.newNode(0, 0, -1))
+	r.order = r.order[:0]
 
-	// locate highest level found on line.
-	// Note the rules say text, but no reordering across line bounds is
-	// performed, so this is sufficient.
-	highestLevel := level(0)
-	lowestOddLevel := level(maxDepth + 2)
+	lowestOddLevel := Level(maxDepth + 2)
 	for _, level := range levels {
-		if level > highestLevel {
-			highestLevel = level
-		}
 		if level&1 != 0 && level < lowestOddLevel {
 			lowestOddLevel = level
 		}
 	}
 
-	for level := highestLevel; level >= lowestOddLevel; level-- {
-		for i := 0; i < len(levels); i++ {
-			if levels[i] >= level {
-				// find range of text at or above this level
-				start := i
-				limit := i + 1
-				for limit < len(levels) && levels[limit] >= level {
-					limit++
-				}
+	for i, level := range levels {
+		// close the sequences that end before i
+		for r.nodes[r.stack[len(r.stack)-1]].lo > level {
+			r.stack = r.stack[:len(r.stack)-1]
+		}
+		top := r.stack[len(r.stack)-1]
+		switch hi := r.nodes[top].hi; {
+		case hi > level:
+			// The sequences of the levels above level end here: move the
+			// children into a node of their own.
+			n := r.newNode(level+1, hi, -1)
+			r.nodes[n].first, r.nodes[n].last = r.nodes[top].first, r.nodes[top].last
+			r.nodes[top].first, r.nodes[top].last = -1, -1
+			r.nodes[top].hi = level
+			r.appendChild(top, n)
+		case hi < level:
+			// start the sequences of the levels from hi+1 to level
+			n := r.newNode(hi+1, level, -1)
+			r.appendChild(top, n)
+			r.stack = append(r.stack, n)
+			top = n
+		}
+		r.appendChild(top, r.newNode(level, level, i))
+	}
 
-				for j, k := start, limit-1; j < k; j, k = j+1, k-1 {
-					result[j], result[k] = result[k], result[j]
-				}
-				// skip to end of level run
-				i = limit
-			}
+	r.visit(0, false, lowestOddLevel)
+	return r.order
+}
+
+// visit appends the leaves of node n in visual order to r.order. The
+// ancestors of n are reversed an odd number of times if reversed is set.
+func (r *reorderer) visit(n int, reversed bool, lowestOddLevel Level) {
+	node := &r.nodes[n]
+	if node.index >= 0 {
+		r.order = append(r.order, node.index)
+		return
+	}
+	lo := maxLevel(node.lo, lowestOddLevel)
+	if node.hi >= lo && (node.hi-lo)&1 == 0 {
+		reversed = !reversed
+	}
+	if reversed {
+		for c := node.last; c >= 0; c = r.nodes[c].prev {
+			r.visit(c, reversed, lowestOddLevel)
+		}
+	} else {
+		for c := node.first; c >= 0; c = r.nodes[c].next {
+			r.visit(c, reversed, lowestOddLevel)
 		}
 	}
+}
 
-	return result
+// The following functions return a slice of length n that reuses the memory
+// of s if it is large enough.
+
+func resizeInts(s []int, n int) []int {
+	if cap(s) < n {
+		return make([]int, n)
+	}
+	return s[:n]
+}
+
+func resizeClasses(s []Class, n int) []Class {
+	if cap(s) < n {
+		return make([]Class, n)
+	}
+	return s[:n]
+}
+
+func resizeLevels(s []Level, n int) []Level {
+	if cap(s) < n {
+		return make([]Level, n)
+	}
+	return s[:n]
 }
 
 // isWhitespace reports whether the type is considered a whitespace type for the
@@ -1006,7 +1392,7 @@ func isRemovedByX9(c Class) bool {
 }
 
 // typeForLevel reports the strong type (L or R) corresponding to the level.
-func typeForLevel(level level) Class {
+func typeForLevel(level Level) Class {
 	if (level & 0x1) == 0 {
 		return L
 	}
@@ -1015,48 +1401,66 @@ func typeForLevel(level level) Class {
 
 func validateTypes(types []Class) error {
 	if len(types) == 0 {
-		return fmt.Errorf("types is null")
+		return ErrEmptyParagraph
 	}
 	for i, t := range types[:len(types)-1] {
 		if t == B {
-			return fmt.Errorf("B type before end of paragraph at index: %d", i)
+			return fmt.Errorf("%w: B type before end of paragraph at index: %d", ErrInternal, i)
 		}
 	}
 	return nil
 }
 
-func validateParagraphEmbeddingLevel(embeddingLevel level) error {
+func validateParagraphEmbeddingLevel(embeddingLevel Level) error {
 	if embeddingLevel != implicitLevel &&
 		embeddingLevel != 0 &&
 		embeddingLevel != 1 {
-		return fmt.Errorf("illegal paragraph embedding level: %d", embeddingLevel)
+		return fmt.Errorf("%w: illegal paragraph embedding level: %d", ErrInvalidLevel, embeddingLevel)
+	}
+	return nil
+}
+
+func validateExplicitLevels(explicitLevels []Level, types []Class) error {
+	if explicitLevels == nil {
+		return nil
+	}
+	if len(explicitLevels) != len(types) {
+		return fmt.Errorf("%w: explicitLevels is different length from types", ErrInternal)
+	}
+	for i, lvl := range explicitLevels {
+		if lvl > maxDepth {
+			return fmt.Errorf("%w: illegal embedding level at %d: %d", ErrInvalidLevel, i, lvl)
+		}
 	}
 	return nil
 }
 
 func validateLineBreaks(linebreaks []int, textLength int) error {
+	if len(linebreaks) == 0 {
+		return &LineBreakError{Reason: "no line breaks"}
+	}
 	prev := 0
 	for i, next := range linebreaks {
 		if next <= prev {
-			return fmt.Errorf("bad linebreak: %d at index: %d", next, i)
+			return &LineBreakError{Index: i, Offset: next, Reason: "not in strictly increasing order"}
 		}
 		prev = next
 	}
 	if prev != textLength {
-		return fmt.Errorf("last linebreak was %d, want %d", prev, textLength)
+		return &LineBreakError{Index: len(linebreaks) - 1, Offset: prev, Reason: fmt.Sprintf("last line break must be %d", textLength)}
 	}
 	return nil
 }
 
 func validatePbTypes(pairTypes []bracketType) error {
 	if len(pairTypes) == 0 {
-		return fmt.Errorf("pairTypes is null")
+		return fmt.Errorf("%w: pairTypes is null", ErrInternal)
 	}
 	for i, pt := range pairTypes {
 		switch pt {
 		case bpNone, bpOpen, bpClose:
 		default:
-			return fmt.Errorf("illegal pairType value at %d: %v", i, pairTypes[i])
+			return fmt.Errorf("%w: illegal pairType value at %d: %v", ErrInternal, i, pairTypes[i])
 		}
 	}
 	return nil
@@ -1064,10 +1468,10 @@ func validatePbTypes(pairTypes []bracketType) error {
 
 func validatePbValues(pairValues []rune, pairTypes []bracketType) error {
 	if pairValues == nil {
-		return fmt.Errorf("pairValues is null")
+		return fmt.Errorf("%w: pairValues is null", ErrInternal)
 	}
 	if len(pairTypes) != len(pairValues) {
-		return fmt.Errorf("pairTypes is different length from pairValues")
+		return fmt.Errorf("%w: pairTypes is different length from pairValues", ErrInternal)
 	}
 	return nil
 }
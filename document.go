@@ -0,0 +1,132 @@
+package sdbidi
+
+import "unicode/utf8"
+
+// A Document holds a text with any number of paragraphs for Bidi processing.
+// The text is split into paragraphs at paragraph separators (rule P1) and each
+// paragraph is resolved with its own paragraph embedding level.
+//
+// Positions reported by the paragraphs of a Document are relative to the start
+// of the paragraph. Pos reports where a paragraph starts within the text.
+type Document struct {
+	paragraphs []*Paragraph
+
+	// runeStarts and starts hold the rune and byte position of each
+	// paragraph, followed by the length of the text.
+	runeStarts []int
+	starts     []int
+}
+
+// paragraphSplitter holds the state shared by Document and Scanner to resolve
+// the paragraphs of a text one after the other.
+type paragraphSplitter struct {
+	opts []Option
+	// inherited holds opts followed by inheritLevel if the direction of a
+	// paragraph without strong characters is inherited (rule HL1).
+	inherited []Option
+	// prev is the paragraph embedding level of the previous paragraph.
+	prev  Level
+	count int
+}
+
+// init prepares sp for a text with the options opts, which o was built from.
+func (sp *paragraphSplitter) init(opts []Option, o *options) {
+	sp.opts, sp.inherited = opts, nil
+	sp.prev, sp.count = 0, 0
+	if o.inheritDirection {
+		// The method value is created once, so resolving a paragraph
+		// does not allocate an option.
+		sp.inherited = append(opts[:len(opts):len(opts)], sp.inheritLevel)
+	}
+}
+
+// inheritLevel sets the paragraph embedding level used by rule HL1 to the
+// level of the previous paragraph.
+func (sp *paragraphSplitter) inheritLevel(opts *options) {
+	opts.defaultLevel = sp.prev
+}
+
+// next sets p to the first paragraph of b and resolves it. It returns the
+// number of bytes and runes of the paragraph, including its separator.
+func (sp *paragraphSplitter) next(p *Paragraph, b []byte) (n, runes int, err error) {
+	opts := sp.opts
+	if sp.inherited != nil && sp.count > 0 {
+		opts = sp.inherited
+	}
+	if n, err = p.SetBytes(b, opts...); err != nil {
+		return 0, 0, err
+	}
+	if len(p.types) > 0 {
+		if err = p.resolve(); err != nil {
+			return 0, 0, err
+		}
+	} else {
+		// A paragraph consisting only of a separator has no strong
+		// characters.
+		p.embeddingLevel = p.options.baseLevel()
+	}
+	sp.prev = p.embeddingLevel
+	sp.count++
+	return n, len(p.runes) + utf8.RuneCount(b[p.offsets[len(p.runes)]:n]), nil
+}
+
+// SetBytes configures d for the given text and resolves all of its
+// paragraphs with the given options. It replaces text previously set by
+// SetBytes or SetString. A paragraph separator belongs to the paragraph it
+// terminates.
+func (d *Document) SetBytes(b []byte, opts ...Option) error {
+	d.paragraphs = nil
+	d.runeStarts = []int{0}
+	d.starts = []int{0}
+
+	o := newOptions(opts)
+	if o.err != nil {
+		return o.err
+	}
+
+	var sp paragraphSplitter
+	sp.init(opts, &o)
+	for start := 0; start < len(b); {
+		p := &Paragraph{}
+		n, runes, err := sp.next(p, b[start:])
+		if err != nil {
+			return err
+		}
+		d.paragraphs = append(d.paragraphs, p)
+		d.runeStarts = append(d.runeStarts, d.runeStarts[len(d.runeStarts)-1]+runes)
+		start += n
+		d.starts = append(d.starts, start)
+	}
+	return nil
+}
+
+// SetString configures d for the given text and resolves all of its
+// paragraphs with the given options. It replaces text previously set by
+// SetBytes or SetString. A paragraph separator belongs to the paragraph it
+// terminates.
+func (d *Document) SetString(s string, opts ...Option) error {
+	return d.SetBytes([]byte(s), opts...)
+}
+
+// NumParagraphs returns the number of paragraphs.
+func (d *Document) NumParagraphs() int {
+	return len(d.paragraphs)
+}
+
+// Paragraph returns the ith paragraph of the text.
+func (d *Document) Paragraph(i int) *Paragraph {
+	return d.paragraphs[i]
+}
+
+// Pos returns the rune position of the ith paragraph within the text passed to
+// SetBytes or SetString, including its paragraph separator.
+func (d *Document) Pos(i int) (start, end int) {
+	return d.runeStarts[i], d.runeStarts[i+1] - 1
+}
+
+// BytePos returns the half-open range of byte offsets of the ith paragraph
+// within the text passed to SetBytes or SetString, including its paragraph
+// separator.
+func (d *Document) BytePos(i int) (start, end int) {
+	return d.starts[i], d.starts[i+1]
+}
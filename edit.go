@@ -0,0 +1,115 @@
+package sdbidi
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Replace replaces the runes from start to end of the paragraph text by text.
+// Like SetBytes and SetString, it invalidates the Orderings and levels
+// obtained from p, and keeps the options of p.
+//
+// The next call of a method that resolves the paragraph, like Order, runs the
+// algorithm again, but reuses the results of the previous run for the
+// isolating run sequences that the edits since then did not affect, unless the
+// MaxBracketPairs option is set. As results are only kept once p has been
+// edited, the first edit of a paragraph does not benefit from this. The
+// results are the same as for setting the edited text with SetBytes or
+// SetString.
+//
+// Results are reused for whole isolating run sequences only, not for single
+// level runs. Text without isolates and embeddings forms a single isolating
+// run sequence, so an edit of such text runs rules W1 to I2 for the whole
+// paragraph again. Isolates, for example around the cells of a table or the
+// fields of a form, limit this work to the sequence that contains the edit.
+// SetBytes, SetString and Reset stop keeping the results.
+//
+// The byte offsets reported for the paragraph refer to the edited text. The
+// text must not contain a paragraph separator. If p belongs to a Document, the
+// positions reported by the Document become invalid.
+func (p *Paragraph) Replace(start, end int, text string) error {
+	n := len(p.runes)
+	if start < 0 || end < start || end > n {
+		return &RangeError{Start: start, End: end, Len: n}
+	}
+	for _, r := range text {
+		if props, _ := LookupRune(r); props.Class() == B {
+			return fmt.Errorf("%w: %U", ErrParagraphSeparator, r)
+		}
+	}
+	length := n - (end - start) + utf8.RuneCountInString(text)
+	if max := p.options.maxLength; max >= 0 && length > max {
+		return &LimitError{Limit: "MaxLength", Max: max}
+	}
+	if len(p.offsets) == 0 {
+		// p has been reset
+		p.offsets = append(p.offsets, 0)
+	}
+
+	// Append the new runes, move them to start and remove the old ones.
+	byteStart, byteEnd := p.offsets[start], p.offsets[end]
+	delta := len(text) - (byteEnd - byteStart)
+	textEnd := p.offsets[n] + delta
+	p.offsets = p.offsets[:n]
+	for i := end; i < n; i++ {
+		p.offsets[i] += delta
+	}
+	for i, r := range text {
+		props, _ := LookupRune(r)
+		p.appendRune(r, props, byteStart+i)
+	}
+	p.reverseInput(start, len(p.runes))
+	inserted := len(p.runes) - n
+	p.reverseInput(start, start+inserted)
+	p.reverseInput(start+inserted, len(p.runes)-(end-start))
+	p.truncateInput(length)
+	p.offsets = append(p.offsets, textEnd)
+
+	p.classes = 0
+	for _, cls := range p.types {
+		p.classes |= 1 << cls
+	}
+
+	if p.para != nil && p.para.prev.valid {
+		p.para.prev.edit(start, end, inserted)
+	}
+	p.edited = true
+	p.cacheMiss, p.cacheText = false, ""
+	p.resolved = false
+	p.levels = p.levels[:0]
+	p.o.clear()
+	return nil
+}
+
+// Insert inserts text before the rune at position pos of the paragraph text.
+// See Replace.
+func (p *Paragraph) Insert(pos int, text string) error {
+	return p.Replace(pos, pos, text)
+}
+
+// Delete removes the runes from start to end of the paragraph text. See
+// Replace.
+func (p *Paragraph) Delete(start, end int) error {
+	return p.Replace(start, end, "")
+}
+
+// reverseInput reverses the order of the runes from i to j of the paragraph
+// text and of their properties and byte offsets.
+func (p *Paragraph) reverseInput(i, j int) {
+	for j--; i < j; i, j = i+1, j-1 {
+		p.runes[i], p.runes[j] = p.runes[j], p.runes[i]
+		p.offsets[i], p.offsets[j] = p.offsets[j], p.offsets[i]
+		p.types[i], p.types[j] = p.types[j], p.types[i]
+		p.pairTypes[i], p.pairTypes[j] = p.pairTypes[j], p.pairTypes[i]
+		p.pairValues[i], p.pairValues[j] = p.pairValues[j], p.pairValues[i]
+	}
+}
+
+// truncateInput removes all but the first n runes of the paragraph text.
+func (p *Paragraph) truncateInput(n int) {
+	p.runes = p.runes[:n]
+	p.offsets = p.offsets[:n]
+	p.types = p.types[:n]
+	p.pairTypes = p.pairTypes[:n]
+	p.pairValues = p.pairValues[:n]
+}
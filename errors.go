@@ -0,0 +1,91 @@
+package sdbidi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The errors returned by this package wrap one of these errors, so they can be
+// tested for with errors.Is.
+var (
+	// ErrEmptyParagraph is returned when resolving or ordering a paragraph
+	// without text.
+	ErrEmptyParagraph = errors.New("sdbidi: empty paragraph")
+
+	// ErrBadLineBreaks is returned for line breaks that are not in strictly
+	// increasing order, do not end at the end of the text or are not at
+	// character boundaries. See LineBreakError.
+	ErrBadLineBreaks = errors.New("sdbidi: bad line breaks")
+
+	// ErrOutOfRange is returned for positions outside of the text. See
+	// RangeError.
+	ErrOutOfRange = errors.New("sdbidi: position out of range")
+
+	// ErrParagraphSeparator is returned for an edit of a Paragraph that
+	// inserts a paragraph separator.
+	ErrParagraphSeparator = errors.New("sdbidi: paragraph separator in inserted text")
+
+	// ErrInvalidDirection is returned for a paragraph direction other than
+	// LeftToRight or RightToLeft.
+	ErrInvalidDirection = errors.New("sdbidi: invalid paragraph direction")
+
+	// ErrInvalidLevel is returned for an embedding level outside of the range
+	// allowed by the algorithm.
+	ErrInvalidLevel = errors.New("sdbidi: invalid embedding level")
+
+	// ErrLimitExceeded is returned for a paragraph that exceeds a limit set
+	// by the MaxLength or WorkBudget option. See LimitError.
+	ErrLimitExceeded = errors.New("sdbidi: limit exceeded")
+
+	// ErrInternal is returned if the algorithm arrives at a state that
+	// violates one of its invariants. It indicates a bug in this package.
+	ErrInternal = errors.New("sdbidi: internal error")
+)
+
+// A LineBreakError reports an invalid line break passed to Lines or
+// LinesBytes, or an invalid start or end of a line passed to LineBytes. It
+// wraps ErrBadLineBreaks.
+type LineBreakError struct {
+	Index  int    // index of the line break; 0 for start and 1 for end of LineBytes
+	Offset int    // the line break, in runes or bytes
+	Reason string // why the line break is invalid
+}
+
+// notAtBoundary is the Reason of a LineBreakError for a byte offset that is
+// not at a character boundary.
+const notAtBoundary = "not at a character boundary"
+
+func (e *LineBreakError) Error() string {
+	return fmt.Sprintf("sdbidi: bad line break %d at index %d: %s", e.Offset, e.Index, e.Reason)
+}
+
+// Unwrap returns ErrBadLineBreaks.
+func (e *LineBreakError) Unwrap() error { return ErrBadLineBreaks }
+
+// A RangeError reports a line or an edit that does not lie within the text of
+// a paragraph. It wraps ErrOutOfRange.
+type RangeError struct {
+	Start, End int // the line or the edited range
+	Len        int // the length of the text
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("sdbidi: range %d-%d out of range for text of length %d", e.Start, e.End, e.Len)
+}
+
+// Unwrap returns ErrOutOfRange.
+func (e *RangeError) Unwrap() error { return ErrOutOfRange }
+
+// A LimitError reports a paragraph that exceeds a limit set by an option. It
+// wraps ErrLimitExceeded.
+type LimitError struct {
+	Limit string // the option that sets the limit: "MaxLength" or "WorkBudget"
+	Max   int    // the value of the limit
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("sdbidi: paragraph exceeds %s of %d", e.Limit, e.Max)
+}
+
+// Unwrap returns ErrLimitExceeded.
+func (e *LimitError) Unwrap() error { return ErrLimitExceeded }
@@ -0,0 +1,300 @@
+//go:build go1.18
+// +build go1.18
+
+package sdbidi
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// fuzzSeeds holds inputs for the fuzz targets. Deeply nested embeddings and
+// isolates exceed the maximum depth of 125.
+var fuzzSeeds = []string{
+	"",
+	"abc",
+	"אבג",
+	"abc אָב (גד) ה xyz",
+	"العاشر ليونيكود (Unicode Conference)،",
+	"a⁧b⁩c⁨א⁩",
+	"1.2 ١٢ $3 %4 +5 ۱,۲",
+	"a\tb c\nd",
+	"‫a‭b‮c‬d‬e‪",
+	"〈〈a〉〉[א]",
+	"\xff\xfe\xc0\x80\xe2\x80\xed\xa0\x80\xf4\x90\x80\x80",
+	strings.Repeat("⁧", 200) + "a" + strings.Repeat("⁩", 130),
+	strings.Repeat("‫‪", 130) + "aא" + strings.Repeat("‬", 300),
+	strings.Repeat("⁨‮(", 100) + "א)",
+}
+
+// fuzzOptions returns the options selected by opt.
+func fuzzOptions(opt uint8) []Option {
+	switch opt % 4 {
+	case 1:
+		return []Option{DefaultDirection(RightToLeft)}
+	case 2:
+		return []Option{ForceDirection(LeftToRight)}
+	case 3:
+		return []Option{ForceDirection(RightToLeft)}
+	}
+	return nil
+}
+
+// checkOrdering checks that the runs of o cover the characters from start to
+// end exactly once, that their levels agree with their directions and that
+// the visual to logical map is a permutation of the characters.
+func checkOrdering(t *testing.T, p *Paragraph, o Ordering, start, end int) {
+	t.Helper()
+	covered := make([]bool, end-start)
+	for i := 0; i < o.NumRuns(); i++ {
+		r := o.Run(i)
+		s, e := r.Pos()
+		if s < start || e >= end || e < s {
+			t.Fatalf("run %d at %d-%d is outside of %d-%d", i, s, e, start, end)
+		}
+		for pos := s; pos <= e; pos++ {
+			if covered[pos-start] {
+				t.Fatalf("character %d is in more than one run", pos)
+			}
+			covered[pos-start] = true
+		}
+		if want := directionForLevel(r.Level()); r.Direction() != want {
+			t.Errorf("run %d at level %d has direction %v", i, r.Level(), r.Direction())
+		}
+		if bs, be := r.BytePos(); bs != p.offsets[s] || be != p.offsets[e+1] {
+			t.Errorf("run %d at %d-%d has byte positions %d-%d, want %d-%d", i, s, e, bs, be, p.offsets[s], p.offsets[e+1])
+		}
+		if got, want := r.String(), string(p.runes[s:e+1]); got != want {
+			t.Errorf("run %d has text %q, want %q", i, got, want)
+		}
+	}
+	for pos, ok := range covered {
+		if !ok {
+			t.Fatalf("character %d is in no run", start+pos)
+		}
+	}
+
+	v2l := o.VisualToLogical()
+	if len(v2l) != end-start {
+		t.Fatalf("VisualToLogical returns %d positions, want %d", len(v2l), end-start)
+	}
+	seen := make([]bool, len(v2l))
+	for _, pos := range v2l {
+		if pos < 0 || pos >= len(v2l) || seen[pos] {
+			t.Fatalf("VisualToLogical is not a permutation: %v", v2l)
+		}
+		seen[pos] = true
+	}
+	for v, pos := range o.LogicalToVisual() {
+		if v2l[pos] != v {
+			t.Fatalf("LogicalToVisual is not the inverse of VisualToLogical")
+		}
+	}
+}
+
+func FuzzParagraph(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add([]byte(s), uint8(0), uint(0))
+		f.Add([]byte(s), uint8(3), uint(7))
+	}
+	f.Fuzz(func(t *testing.T, b []byte, opt uint8, brk uint) {
+		var p Paragraph
+		n, err := p.SetBytes(b, fuzzOptions(opt)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n < 0 || n > len(b) {
+			t.Fatalf("SetBytes consumed %d of %d bytes", n, len(b))
+		}
+		if n < len(b) {
+			if r, _ := utf8.DecodeLastRune(b[:n]); r != '\n' && r != '\r' && r != 0x1C && r != 0x1D && r != 0x1E && r != 0x85 && r != 0x2029 {
+				t.Fatalf("SetBytes stopped after %U, which is not a paragraph separator", r)
+			}
+		}
+
+		o, err := p.Order()
+		count := len(p.runes)
+		if count == 0 {
+			if !errors.Is(err, ErrEmptyParagraph) {
+				t.Fatalf("Order must return ErrEmptyParagraph for an empty paragraph but got %v", err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkOrdering(t, &p, o, 0, count)
+		levels, err := p.Levels()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(levels) != count {
+			t.Fatalf("Levels returns %d levels, want %d", len(levels), count)
+		}
+		for i, lvl := range levels {
+			if lvl > maxDepth+1 {
+				t.Fatalf("level %d at %d exceeds the maximum depth", lvl, i)
+			}
+		}
+		if p.fastPath {
+			var para paragraph
+			want, err := fullLevels(&para, &p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range want {
+				if levels[i] != want[i] {
+					t.Fatalf("fast path gives level %d at %d, want %d", levels[i], i, want[i])
+				}
+			}
+		}
+
+		start := int(brk % uint(count))
+		end := start + 1 + int(brk/uint(count)%uint(count-start))
+		line, err := p.Line(start, end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkOrdering(t, &p, line, start, end)
+
+		linebreaks := []int{count}
+		if start > 0 {
+			linebreaks = []int{start, count}
+		}
+		lines, err := p.Lines(linebreaks)
+		if err != nil {
+			t.Fatal(err)
+		}
+		prev := 0
+		for i, l := range lines {
+			checkOrdering(t, &p, l, prev, linebreaks[i])
+			prev = linebreaks[i]
+		}
+
+		// A reused paragraph must give the same result as a new one.
+		var q Paragraph
+		if _, err := q.SetString("א (abc) ⁧ב", ForceDirection(RightToLeft)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := q.Order(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := q.SetBytes(b, fuzzOptions(opt)...); err != nil {
+			t.Fatal(err)
+		}
+		reused, err := q.Levels()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.IsLeftToRight() != p.IsLeftToRight() || len(reused) != len(levels) {
+			t.Fatalf("reused paragraph differs from a new one")
+		}
+		for i := range levels {
+			if reused[i] != levels[i] {
+				t.Fatalf("level at %d of a reused paragraph is %d, want %d", i, reused[i], levels[i])
+			}
+		}
+
+		// A paragraph set from a cache must give the same result as well.
+		c := NewCache(1)
+		for i := 0; i < 2; i++ {
+			cn, err := q.SetBytes(b, append(fuzzOptions(opt), UseCache(c))...)
+			if err != nil || cn != n {
+				t.Fatalf("SetBytes with a cache consumed %d bytes with error %v, want %d", cn, err, n)
+			}
+			cached, err := q.Levels()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if q.IsLeftToRight() != p.IsLeftToRight() || levelsString(cached) != levelsString(levels) {
+				t.Fatalf("paragraph set from a cache differs from a new one")
+			}
+			cline, err := q.Line(start, end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cline.Equal(&line) {
+				t.Fatalf("line of a paragraph set from a cache differs from a new one")
+			}
+		}
+		if s := c.Stats(); s.Hits != 1 {
+			t.Fatalf("the second paragraph should be found in the cache: %+v", s)
+		}
+	})
+}
+
+func FuzzEdit(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s, uint8(0), uint(3), "a⁧", uint(17), "")
+		f.Add(s, uint8(1), uint(1), "(א", uint(5), "‬)")
+	}
+	f.Fuzz(func(t *testing.T, s string, opt uint8, edit1 uint, text1 string, edit2 uint, text2 string) {
+		s, text1, text2 = strings.ToValidUTF8(s, "\uFFFD"), strings.ToValidUTF8(text1, "\uFFFD"), strings.ToValidUTF8(text2, "\uFFFD")
+		var p Paragraph
+		if _, err := p.SetString(s, fuzzOptions(opt)...); err != nil {
+			t.Fatal(err)
+		}
+		model := append([]rune(nil), p.runes...)
+		for i, edit := range []struct {
+			pos  uint
+			text string
+		}{{edit1, text1}, {edit2, text2}, {edit1 / 3, text2}, {edit2 / 5, text1}} {
+			start := int(edit.pos % uint(len(model)+1))
+			end := start + int(edit.pos/7%uint(len(model)-start+1))
+			err := p.Replace(start, end, edit.text)
+			if errors.Is(err, ErrParagraphSeparator) {
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			model = append(model[:start:start], append([]rune(edit.text), model[end:]...)...)
+			if i != 2 {
+				checkEdited(t, &p, string(model), fuzzOptions(opt)...)
+			}
+		}
+	})
+}
+
+func FuzzReverse(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add([]byte("prefix"), []byte(s))
+	}
+	f.Fuzz(func(t *testing.T, out, in []byte) {
+		s := ReverseString(string(in))
+		if n, want := utf8.RuneCountInString(s), utf8.RuneCount(in); n != want {
+			t.Fatalf("ReverseString returns %d characters, want %d", n, want)
+		}
+		b := AppendReverse(append([]byte(nil), out...), in)
+		if !bytes.HasPrefix(b, out) || string(b[len(out):]) != s {
+			t.Fatalf("AppendReverse returns %q, want %q followed by %q", b, out, s)
+		}
+	})
+}
+
+func FuzzLookup(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add([]byte(s))
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		p, n := Lookup(b)
+		ps, ns := LookupString(string(b))
+		if p != ps || n != ns {
+			t.Fatalf("Lookup returns %v, %d, LookupString returns %v, %d", p, n, ps, ns)
+		}
+		if n < 0 || n > len(b) || n > utf8.UTFMax || (n == 0 && len(b) > 0 && (b[0] < 0xC2 || len(b) >= utf8.UTFMax)) {
+			t.Fatalf("Lookup returns size %d for %q", n, b)
+		}
+		if r, size := utf8.DecodeRune(b); r != utf8.RuneError || size > 1 {
+			if n != size {
+				t.Fatalf("Lookup returns size %d for %U, want %d", n, r, size)
+			}
+			if pr, _ := LookupRune(r); pr != p {
+				t.Fatalf("Lookup and LookupRune disagree for %U", r)
+			}
+		}
+	})
+}
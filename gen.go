@@ -0,0 +1,857 @@
+//go:build ignore
+// +build ignore
+
+// This program generates tables<version>.go, which holds the trie of Bidi
+// classes and brackets and the mirroring tables, and trieval.go from the
+// Unicode Character Database. It reads the following files from the directory
+// testdata/ucd/<version>:
+//
+//	UnicodeData.txt
+//	DerivedBidiClass.txt
+//	BidiBrackets.txt
+//	BidiMirroring.txt
+//
+// The files are available from https://www.unicode.org/Public/<version>/ucd/,
+// where DerivedBidiClass.txt is found in the extracted directory. Run
+//
+//	go run gen.go gen_trieval.go gen_ranges.go -unicode 12.0.0 -tags "sdbidi_unicode12 && !sdbidi_unicode15"
+//
+// to generate the tables for another version than the default. The tables of
+// all versions share the same names, so the build constraints given with -tags
+// must select exactly one of them. Each version has a tag of its own, and the
+// tables of the most recent version are also used if no tag is given.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/build/constraint"
+	"go/format"
+	"hash/crc32"
+	"hash/fnv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	unicodeVersion = flag.String("unicode", "15.0.0", "Unicode version of the generated tables")
+	tags           = flag.String("tags", "", "build constraint of the generated tables, for example sdbidi_unicode15")
+	ucdDir         = flag.String("ucd", "testdata/ucd", "directory holding a directory of UCD files for each Unicode version")
+	output         = flag.String("output", "", "file name of the generated tables (default tables<version>.go)")
+)
+
+const header = "// Code generated by running \"go generate\" in github.com/speedata/sdbidi. DO NOT EDIT.\n\n"
+
+func main() {
+	flag.Parse()
+	if *output == "" {
+		*output = "tables" + *unicodeVersion + ".go"
+	}
+	u, err := loadUCD(filepath.Join(*ucdDir, *unicodeVersion))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = writeFile(*output, genTables(u)); err != nil {
+		log.Fatal(err)
+	}
+	if err = genTrieval(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+const maxRune = 0x10FFFF
+
+// ucd holds the properties of all code points relevant to this package.
+type ucd struct {
+	classes  []Class
+	brackets []bracket
+	mirrors  []rune // the Bidi_Mirroring_Glyph or 0
+	mirrored []bool // the Bidi_Mirrored property
+}
+
+// bracket holds the Bidi_Paired_Bracket and Bidi_Paired_Bracket_Type
+// properties of a code point.
+type bracket struct {
+	pair rune
+	open bool
+}
+
+var classNames = map[string]Class{
+	"L":   L,
+	"R":   R,
+	"EN":  EN,
+	"ES":  ES,
+	"ET":  ET,
+	"AN":  AN,
+	"CS":  CS,
+	"B":   B,
+	"S":   S,
+	"WS":  WS,
+	"ON":  ON,
+	"BN":  BN,
+	"NSM": NSM,
+	"AL":  AL,
+	"LRO": LRO,
+	"RLO": RLO,
+	"LRE": LRE,
+	"RLE": RLE,
+	"PDF": PDF,
+	"LRI": LRI,
+	"RLI": RLI,
+	"FSI": FSI,
+	"PDI": PDI,
+
+	// Long names as used in @missing lines.
+	"Left_To_Right":           L,
+	"Right_To_Left":           R,
+	"European_Number":         EN,
+	"European_Separator":      ES,
+	"European_Terminator":     ET,
+	"Arabic_Number":           AN,
+	"Common_Separator":        CS,
+	"Paragraph_Separator":     B,
+	"Segment_Separator":       S,
+	"White_Space":             WS,
+	"Other_Neutral":           ON,
+	"Boundary_Neutral":        BN,
+	"Nonspacing_Mark":         NSM,
+	"Arabic_Letter":           AL,
+	"Left_To_Right_Override":  LRO,
+	"Right_To_Left_Override":  RLO,
+	"Left_To_Right_Embedding": LRE,
+	"Right_To_Left_Embedding": RLE,
+	"Pop_Directional_Format":  PDF,
+	"Left_To_Right_Isolate":   LRI,
+	"Right_To_Left_Isolate":   RLI,
+	"First_Strong_Isolate":    FSI,
+	"Pop_Directional_Isolate": PDI,
+}
+
+func parseClass(s string) (Class, error) {
+	c, ok := classNames[s]
+	if !ok {
+		return 0, fmt.Errorf("unknown Bidi class %q", s)
+	}
+	return c, nil
+}
+
+// loadUCD reads the UCD files from dir. The Bidi classes of DerivedBidiClass.txt
+// are checked against those of UnicodeData.txt.
+func loadUCD(dir string) (*ucd, error) {
+	u := &ucd{
+		classes:  make([]Class, maxRune+1),
+		brackets: make([]bracket, maxRune+1),
+		mirrors:  make([]rune, maxRune+1),
+		mirrored: make([]bool, maxRune+1),
+	}
+
+	derived := filepath.Join(dir, "DerivedBidiClass.txt")
+	err := visitDefaults(derived, func(lo, hi rune, c Class) {
+		for r := lo; r <= hi; r++ {
+			u.classes[r] = c
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = parseUCD(derived, func(lo, hi rune, fields []string) error {
+		c, err := parseClass(fields[1])
+		if err != nil {
+			return err
+		}
+		for r := lo; r <= hi; r++ {
+			u.classes[r] = c
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var first rune
+	err = parseUCD(filepath.Join(dir, "UnicodeData.txt"), func(r, _ rune, fields []string) error {
+		if len(fields) < 10 {
+			return fmt.Errorf("U+%04X: expected 15 fields, got %d", r, len(fields))
+		}
+		lo := r
+		switch {
+		case strings.HasSuffix(fields[1], ", First>"):
+			first = r
+			return nil
+		case strings.HasSuffix(fields[1], ", Last>"):
+			lo = first
+		}
+		c, err := parseClass(fields[4])
+		if err != nil {
+			return err
+		}
+		for ; lo <= r; lo++ {
+			if u.classes[lo] != c {
+				return fmt.Errorf("U+%04X has class %s in UnicodeData.txt, but %s in DerivedBidiClass.txt", lo, fields[4], className(u.classes[lo]))
+			}
+			u.mirrored[lo] = fields[9] == "Y"
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = parseUCD(filepath.Join(dir, "BidiBrackets.txt"), func(r, _ rune, fields []string) error {
+		pair, err := parseRune(fields[1])
+		if err != nil {
+			return err
+		}
+		switch fields[2] {
+		case "o":
+			u.brackets[r] = bracket{pair: pair, open: true}
+		case "c":
+			u.brackets[r] = bracket{pair: pair}
+		default:
+			return fmt.Errorf("U+%04X: unknown bracket type %q", r, fields[2])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = parseUCD(filepath.Join(dir, "BidiMirroring.txt"), func(r, _ rune, fields []string) error {
+		m, err := parseRune(fields[1])
+		if err != nil {
+			return err
+		}
+		u.mirrors[r] = m
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func className(c Class) string {
+	for name, v := range classNames {
+		if v == c && len(name) <= 3 {
+			return name
+		}
+	}
+	return strconv.Itoa(int(c))
+}
+
+// parseUCD calls fn for each data line of the UCD file path with the range
+// of code points of the first field and all fields of the line.
+func parseUCD(path string, fn func(lo, hi rune, fields []string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		fields := strings.Split(text, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		lo, hi, err := parseRange(fields[0])
+		if err == nil {
+			err = fn(lo, hi, fields)
+		}
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+	}
+	return s.Err()
+}
+
+func parseRange(s string) (lo, hi rune, err error) {
+	i := strings.Index(s, "..")
+	if i < 0 {
+		lo, err = parseRune(s)
+		return lo, lo, err
+	}
+	if lo, err = parseRune(s[:i]); err != nil {
+		return 0, 0, err
+	}
+	if hi, err = parseRune(s[i+2:]); err != nil {
+		return 0, 0, err
+	}
+	if hi < lo {
+		return 0, 0, fmt.Errorf("invalid range %q", s)
+	}
+	return lo, hi, nil
+}
+
+func parseRune(s string) (rune, error) {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil || r > maxRune {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+	return rune(r), nil
+}
+
+// A trie entry has the following bits:
+// 7..5  XOR mask for brackets
+// 4     1: Bracket open, 0: Bracket close
+// 3..0  Class type
+func (u *ucd) trieValues() (values []uint8, xorMasks []int32, err error) {
+	values = make([]uint8, maxRune+1)
+	xorMasks = []int32{0}
+	for r := rune(0); r <= maxRune; r++ {
+		c := u.classes[r]
+		if c > numClass {
+			c = Control
+		}
+		v := uint8(c)
+		if b := u.brackets[r]; b.pair != 0 {
+			mask := int32(r ^ b.pair)
+			i := 0
+			for i < len(xorMasks) && xorMasks[i] != mask {
+				i++
+			}
+			if i == len(xorMasks) {
+				xorMasks = append(xorMasks, mask)
+			}
+			if i >= 1<<(8-xorMaskShift) {
+				return nil, nil, fmt.Errorf("too many bracket xor masks: %v", xorMasks)
+			}
+			v |= uint8(i) << xorMaskShift
+			if b.open {
+				v |= openMask
+			}
+		}
+		values[r] = v
+	}
+	return values, xorMasks, nil
+}
+
+func genTables(u *ucd) []byte {
+	values, xorMasks, err := u.trieValues()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := &bytes.Buffer{}
+	w.WriteString(header)
+	if *tags != "" {
+		expr, err := constraint.Parse("//go:build " + *tags)
+		if err != nil {
+			log.Fatal(err)
+		}
+		lines, err := constraint.PlusBuildLines(expr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(w, "//go:build %s\n", expr)
+		for _, l := range lines {
+			fmt.Fprintf(w, "%s\n", l)
+		}
+		w.WriteString("\n")
+	}
+	w.WriteString("package sdbidi\n\n")
+	fmt.Fprintf(w, "// UnicodeVersion is the Unicode version from which the tables in this package are derived.\n")
+	fmt.Fprintf(w, "const UnicodeVersion = %q\n\n", *unicodeVersion)
+
+	size := 24 + len(xorMasks)*4 // including the slice header
+	fmt.Fprintf(w, "// xorMasks contains masks to be xor-ed with brackets to get the reverse\n// version.\n")
+	fmt.Fprintf(w, "var xorMasks = []int32{ // %d elements\n\t", len(xorMasks))
+	for i, m := range xorMasks {
+		if i > 0 {
+			w.WriteString(" ")
+		}
+		fmt.Fprintf(w, "%d,", m)
+	}
+	fmt.Fprintf(w, "\n} // Size: %d bytes\n\n", size)
+
+	t := newTrie(values)
+	size += t.write(w)
+	size += writeMirrors(w, u)
+
+	fmt.Fprintf(w, "\n// Total table size %d bytes (%dKiB); checksum: %X\n", size, size/1024, crc32.ChecksumIEEE(w.Bytes()))
+	return w.Bytes()
+}
+
+func writeMirrors(w *bytes.Buffer, u *ucd) (size int) {
+	var glyphs [][2]rune
+	var noGlyph []rune
+	for r := rune(0); r <= maxRune; r++ {
+		if m := u.mirrors[r]; m != 0 {
+			glyphs = append(glyphs, [2]rune{r, m})
+		} else if u.mirrored[r] {
+			noGlyph = append(noGlyph, r)
+		}
+	}
+
+	fmt.Fprintf(w, "\n// mirrorGlyphs maps the characters that have the Bidi_Mirroring_Glyph\n// property to their mirror glyph. It is sorted by the first element.\n")
+	fmt.Fprintf(w, "var mirrorGlyphs = [%d][2]int32{ // %d elements\n", len(glyphs), len(glyphs))
+	for i, g := range glyphs {
+		switch {
+		case i%4 == 0:
+			w.WriteString("\t")
+		default:
+			w.WriteString(" ")
+		}
+		fmt.Fprintf(w, "{0x%04X, 0x%04X},", g[0], g[1])
+		if i%4 == 3 || i == len(glyphs)-1 {
+			w.WriteString("\n")
+		}
+	}
+	fmt.Fprintf(w, "} // Size: %d bytes\n", len(glyphs)*8)
+
+	fmt.Fprintf(w, "\n// mirroredNoGlyph contains the characters that have the Bidi_Mirrored\n// property, but no mirror glyph. It is sorted.\n")
+	fmt.Fprintf(w, "var mirroredNoGlyph = [%d]int32{ // %d elements\n", len(noGlyph), len(noGlyph))
+	for i, r := range noGlyph {
+		switch {
+		case i%8 == 0:
+			w.WriteString("\t")
+		default:
+			w.WriteString(" ")
+		}
+		fmt.Fprintf(w, "0x%04X,", r)
+		if i%8 == 7 || i == len(noGlyph)-1 {
+			w.WriteString("\n")
+		}
+	}
+	fmt.Fprintf(w, "} // Size: %d bytes\n", len(noGlyph)*4)
+	return len(glyphs)*8 + len(noGlyph)*4
+}
+
+// The trie is a UTF-8 trie with blocks of 64 entries. The first two value
+// blocks hold the values for ASCII, the third one is the zero block. The index
+// blocks 0 to 2 are zero, block 3 is the root block and holds the index for
+// the lead bytes 0xC0 to 0xFF at offset 0xC0.
+const (
+	blockSize = 64
+	rootBlock = 3
+)
+
+type trieNode struct {
+	children []*trieNode // for index nodes
+	values   []uint8     // for value nodes
+	index    int
+}
+
+type trie struct {
+	ascii       []uint8
+	root        *trieNode
+	valueBlocks [][]uint8
+	indexBlocks []*trieNode
+
+	valueIdx map[uint64]int
+	indexIdx map[uint64]int
+}
+
+func newTrie(values []uint8) *trie {
+	t := &trie{
+		ascii:    values[:utf8.RuneSelf],
+		root:     &trieNode{children: make([]*trieNode, blockSize)},
+		valueIdx: map[uint64]int{},
+		indexIdx: map[uint64]int{},
+	}
+	var buf [utf8.UTFMax]byte
+	for r := rune(utf8.RuneSelf); r <= maxRune; r++ {
+		if values[r] == 0 || !utf8.ValidRune(r) {
+			continue
+		}
+		n := utf8.EncodeRune(buf[:], r)
+		node := t.root
+		for i, b := range buf[:n-1] {
+			if i > 0 {
+				b -= 0x80
+			} else {
+				b -= 0xC0
+			}
+			child := node.children[b]
+			if child == nil {
+				child = &trieNode{}
+				if i < n-2 {
+					child.children = make([]*trieNode, blockSize)
+				} else {
+					child.values = make([]uint8, blockSize)
+				}
+				node.children[b] = child
+			}
+			node = child
+		}
+		node.values[buf[n-1]-0x80] = values[r]
+	}
+
+	// Blocks 0 to 2 of the values are the two ASCII blocks and the zero
+	// block; the zero block has the index 0. The index blocks before the
+	// root block are zero.
+	t.valueBlocks = [][]uint8{t.ascii[:blockSize], t.ascii[blockSize:], make([]uint8, blockSize)}
+	t.indexBlocks = []*trieNode{{}, {}, {}, t.root}
+	t.computeOffsets(t.root)
+	return t
+}
+
+// computeOffsets assigns block indexes to all nodes below n and returns a
+// hash of the values of n. Identical blocks are shared. Value blocks with
+// index n are stored at block n+2, index blocks with index n at block n+2.
+func (t *trie) computeOffsets(n *trieNode) uint64 {
+	if n == nil {
+		return 0
+	}
+	h := fnv.New64a()
+	zero := true
+	var buf [8]byte
+	for _, c := range n.children {
+		v := t.computeOffsets(c)
+		if v != 0 {
+			zero = false
+		}
+		for i := range buf {
+			buf[i] = byte(v >> (8 * uint(i)))
+		}
+		h.Write(buf[:])
+	}
+	for _, v := range n.values {
+		if v != 0 {
+			zero = false
+		}
+	}
+	h.Write(n.values)
+	if zero {
+		n.index = 0
+		return 0
+	}
+	hash := h.Sum64()
+	if n == t.root {
+		return hash
+	}
+	if n.children != nil {
+		i, ok := t.indexIdx[hash]
+		if !ok {
+			i = len(t.indexBlocks) - 2
+			t.indexBlocks = append(t.indexBlocks, n)
+			t.indexIdx[hash] = i
+		}
+		n.index = i
+	} else {
+		i, ok := t.valueIdx[hash]
+		if !ok {
+			i = len(t.valueBlocks) - 2
+			t.valueBlocks = append(t.valueBlocks, n.values)
+			t.valueIdx[hash] = i
+		}
+		n.index = i
+	}
+	return hash
+}
+
+// write writes the lookup code and the tables of the trie to w and returns
+// the size of the tables.
+func (t *trie) write(w *bytes.Buffer) int {
+	var values []uint8
+	for _, b := range t.valueBlocks {
+		values = append(values, b...)
+	}
+	index := make([]int, 0, len(t.indexBlocks)*blockSize)
+	maxIndex := 0
+	for _, b := range t.indexBlocks {
+		for i := 0; i < blockSize; i++ {
+			v := 0
+			if b.children != nil && b.children[i] != nil {
+				v = b.children[i].index
+			}
+			if v > maxIndex {
+				maxIndex = v
+			}
+			index = append(index, v)
+		}
+	}
+	indexType, indexSize := "uint8", 1
+	if maxIndex > 0xFF {
+		indexType, indexSize = "uint16", 2
+	}
+
+	h := fnv.New64a()
+	h.Write(values)
+	for _, v := range index {
+		h.Write([]byte{byte(v >> 8), byte(v)})
+	}
+	size := len(values) + len(index)*indexSize
+
+	w.WriteString(lookupCode)
+	fmt.Fprintf(w, "// bidiTrie. Total size: %d bytes (%.2f KiB). Checksum: %x.\n", size, float64(size)/1024, h.Sum64())
+	w.WriteString(trieCode)
+
+	fmt.Fprintf(w, "// bidiValues: %d blocks, %d entries, %d bytes\n", len(t.valueBlocks), len(values), len(values))
+	fmt.Fprintf(w, "// The third block is the zero block.\n")
+	fmt.Fprintf(w, "var bidiValues = [%d]uint8{\n", len(values))
+	writeBlocks(w, len(values), 6, func(i int) string {
+		if values[i] == 0 {
+			return ""
+		}
+		return fmt.Sprintf("0x%02x: 0x%04x,", i, values[i])
+	})
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "// bidiIndex: %d blocks, %d entries, %d bytes\n", len(t.indexBlocks), len(index), len(index)*indexSize)
+	fmt.Fprintf(w, "// Block 0 is the zero block.\n")
+	fmt.Fprintf(w, "var bidiIndex = [%d]%s{\n", len(index), indexType)
+	writeBlocks(w, len(index), 8, func(i int) string {
+		if index[i] == 0 {
+			return ""
+		}
+		return fmt.Sprintf("0x%02x: 0x%02x,", i, index[i])
+	})
+	fmt.Fprintf(w, "}\n")
+	return size
+}
+
+// writeBlocks writes the n non-empty entries returned by entry with perLine
+// entries per line and a comment at the start of each block.
+func writeBlocks(w *bytes.Buffer, n, perLine int, entry func(i int) string) {
+	inLine := false
+	for i := 0; i < n; i++ {
+		if i%blockSize%perLine == 0 && inLine {
+			w.WriteString("\n")
+			inLine = false
+		}
+		if i%blockSize == 0 {
+			fmt.Fprintf(w, "\t// Block %#x, offset %#x\n", i/blockSize, i)
+		}
+		e := entry(i)
+		if e == "" {
+			continue
+		}
+		if inLine {
+			w.WriteString(" ")
+		} else {
+			w.WriteString("\t")
+			inLine = true
+		}
+		w.WriteString(e)
+	}
+	if inLine {
+		w.WriteString("\n")
+	}
+}
+
+// genTrieval writes trieval.go from gen_trieval.go.
+func genTrieval() error {
+	b, err := ioutil.ReadFile("gen_trieval.go")
+	if err != nil {
+		return err
+	}
+	src := string(b)
+	i := strings.Index(src, "package main\n")
+	if i < 0 {
+		return fmt.Errorf("gen_trieval.go: package clause not found")
+	}
+	src = header + "package sdbidi\n" + src[i+len("package main\n"):]
+	return writeFile("trieval.go", []byte(src))
+}
+
+func writeFile(name string, src []byte) error {
+	b, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return ioutil.WriteFile(name, b, 0644)
+}
+
+const lookupCode = `// lookup returns the trie value for the first UTF-8 encoding in s and
+// the width in bytes of this encoding. The size will be 0 if s does not
+// hold enough bytes to complete the encoding. len(s) must be greater than 0.
+func (t *bidiTrie) lookup(s []byte) (v uint8, sz int) {
+	c0 := s[0]
+	switch {
+	case c0 < 0x80: // is ASCII
+		return bidiValues[c0], 1
+	case c0 < 0xC2:
+		return 0, 1 // Illegal UTF-8: not a starter, not ASCII.
+	case c0 < 0xE0: // 2-byte UTF-8
+		if len(s) < 2 {
+			return 0, 0
+		}
+		i := bidiIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		return t.lookupValue(uint32(i), c1), 2
+	case c0 < 0xF0: // 3-byte UTF-8
+		if len(s) < 3 {
+			return 0, 0
+		}
+		i := bidiIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		o := uint32(i)<<6 + uint32(c1)
+		i = bidiIndex[o]
+		c2 := s[2]
+		if c2 < 0x80 || 0xC0 <= c2 {
+			return 0, 2 // Illegal UTF-8: not a continuation byte.
+		}
+		return t.lookupValue(uint32(i), c2), 3
+	case c0 < 0xF8: // 4-byte UTF-8
+		if len(s) < 4 {
+			return 0, 0
+		}
+		i := bidiIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		o := uint32(i)<<6 + uint32(c1)
+		i = bidiIndex[o]
+		c2 := s[2]
+		if c2 < 0x80 || 0xC0 <= c2 {
+			return 0, 2 // Illegal UTF-8: not a continuation byte.
+		}
+		o = uint32(i)<<6 + uint32(c2)
+		i = bidiIndex[o]
+		c3 := s[3]
+		if c3 < 0x80 || 0xC0 <= c3 {
+			return 0, 3 // Illegal UTF-8: not a continuation byte.
+		}
+		return t.lookupValue(uint32(i), c3), 4
+	}
+	// Illegal rune
+	return 0, 1
+}
+
+// lookupUnsafe returns the trie value for the first UTF-8 encoding in s.
+// s must start with a full and valid UTF-8 encoded rune.
+func (t *bidiTrie) lookupUnsafe(s []byte) uint8 {
+	c0 := s[0]
+	if c0 < 0x80 { // is ASCII
+		return bidiValues[c0]
+	}
+	i := bidiIndex[c0]
+	if c0 < 0xE0 { // 2-byte UTF-8
+		return t.lookupValue(uint32(i), s[1])
+	}
+	i = bidiIndex[uint32(i)<<6+uint32(s[1])]
+	if c0 < 0xF0 { // 3-byte UTF-8
+		return t.lookupValue(uint32(i), s[2])
+	}
+	i = bidiIndex[uint32(i)<<6+uint32(s[2])]
+	if c0 < 0xF8 { // 4-byte UTF-8
+		return t.lookupValue(uint32(i), s[3])
+	}
+	return 0
+}
+
+// lookupString returns the trie value for the first UTF-8 encoding in s and
+// the width in bytes of this encoding. The size will be 0 if s does not
+// hold enough bytes to complete the encoding. len(s) must be greater than 0.
+func (t *bidiTrie) lookupString(s string) (v uint8, sz int) {
+	c0 := s[0]
+	switch {
+	case c0 < 0x80: // is ASCII
+		return bidiValues[c0], 1
+	case c0 < 0xC2:
+		return 0, 1 // Illegal UTF-8: not a starter, not ASCII.
+	case c0 < 0xE0: // 2-byte UTF-8
+		if len(s) < 2 {
+			return 0, 0
+		}
+		i := bidiIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		return t.lookupValue(uint32(i), c1), 2
+	case c0 < 0xF0: // 3-byte UTF-8
+		if len(s) < 3 {
+			return 0, 0
+		}
+		i := bidiIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		o := uint32(i)<<6 + uint32(c1)
+		i = bidiIndex[o]
+		c2 := s[2]
+		if c2 < 0x80 || 0xC0 <= c2 {
+			return 0, 2 // Illegal UTF-8: not a continuation byte.
+		}
+		return t.lookupValue(uint32(i), c2), 3
+	case c0 < 0xF8: // 4-byte UTF-8
+		if len(s) < 4 {
+			return 0, 0
+		}
+		i := bidiIndex[c0]
+		c1 := s[1]
+		if c1 < 0x80 || 0xC0 <= c1 {
+			return 0, 1 // Illegal UTF-8: not a continuation byte.
+		}
+		o := uint32(i)<<6 + uint32(c1)
+		i = bidiIndex[o]
+		c2 := s[2]
+		if c2 < 0x80 || 0xC0 <= c2 {
+			return 0, 2 // Illegal UTF-8: not a continuation byte.
+		}
+		o = uint32(i)<<6 + uint32(c2)
+		i = bidiIndex[o]
+		c3 := s[3]
+		if c3 < 0x80 || 0xC0 <= c3 {
+			return 0, 3 // Illegal UTF-8: not a continuation byte.
+		}
+		return t.lookupValue(uint32(i), c3), 4
+	}
+	// Illegal rune
+	return 0, 1
+}
+
+// lookupStringUnsafe returns the trie value for the first UTF-8 encoding in s.
+// s must start with a full and valid UTF-8 encoded rune.
+func (t *bidiTrie) lookupStringUnsafe(s string) uint8 {
+	c0 := s[0]
+	if c0 < 0x80 { // is ASCII
+		return bidiValues[c0]
+	}
+	i := bidiIndex[c0]
+	if c0 < 0xE0 { // 2-byte UTF-8
+		return t.lookupValue(uint32(i), s[1])
+	}
+	i = bidiIndex[uint32(i)<<6+uint32(s[1])]
+	if c0 < 0xF0 { // 3-byte UTF-8
+		return t.lookupValue(uint32(i), s[2])
+	}
+	i = bidiIndex[uint32(i)<<6+uint32(s[2])]
+	if c0 < 0xF8 { // 4-byte UTF-8
+		return t.lookupValue(uint32(i), s[3])
+	}
+	return 0
+}
+
+
+`
+
+const trieCode = `type bidiTrie struct{}
+
+func newBidiTrie(i int) *bidiTrie {
+	return &bidiTrie{}
+}
+
+// lookupValue determines the type of block n and looks up the value for b.
+func (t *bidiTrie) lookupValue(n uint32, b byte) uint8 {
+	switch {
+	default:
+		return uint8(bidiValues[n<<6+uint32(b)])
+	}
+}
+
+`
@@ -0,0 +1,51 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// visitDefaults calls fn with the default Bidi class of the ranges of code
+// points in the @missing lines of DerivedBidiClass.txt, for example
+//
+//	# @missing: 0590..05FF; R
+//
+// Later lines take precedence over earlier ones. Unassigned code points in
+// ranges reserved for right-to-left scripts default to R or AL. Older versions
+// of the file only contain a line for 0000..10FFFF and list these code points
+// explicitly. All code points default to L if there are no such lines.
+func visitDefaults(path string, fn func(lo, hi rune, c Class)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	const prefix = "# @missing:"
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		text := s.Text()
+		if !strings.HasPrefix(text, prefix) {
+			continue
+		}
+		fields := strings.Split(text[len(prefix):], ";")
+		if len(fields) < 2 {
+			return fmt.Errorf("%s:%d: invalid @missing line", path, line)
+		}
+		lo, hi, err := parseRange(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		c, err := parseClass(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		fn(lo, hi, c)
+	}
+	return s.Err()
+}
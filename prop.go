@@ -4,7 +4,10 @@
 
 package sdbidi
 
-import "unicode/utf8"
+import (
+	"sort"
+	"unicode/utf8"
+)
 
 // Properties provides access to BiDi properties of runes.
 type Properties struct {
@@ -45,6 +48,33 @@ func (p Properties) reverseBracket(r rune) rune {
 	return xorMasks[p.entry>>xorMaskShift] ^ r
 }
 
+// MirrorGlyph returns the character that has the mirrored glyph of r and true,
+// for example ")" for "(" or "≥" for "≤". Characters in right-to-left
+// runs are displayed with this glyph (rule L4). If no such character exists,
+// MirrorGlyph returns r and false.
+func MirrorGlyph(r rune) (rune, bool) {
+	i := sort.Search(len(mirrorGlyphs), func(i int) bool {
+		return mirrorGlyphs[i][0] >= r
+	})
+	if i < len(mirrorGlyphs) && mirrorGlyphs[i][0] == r {
+		return mirrorGlyphs[i][1], true
+	}
+	return r, false
+}
+
+// IsMirrored reports whether r has the Bidi_Mirrored property. Some of these
+// characters, for example "∁", have no mirror glyph and require a
+// mirrored rendering of the glyph itself in right-to-left runs.
+func IsMirrored(r rune) bool {
+	if _, ok := MirrorGlyph(r); ok {
+		return true
+	}
+	i := sort.Search(len(mirroredNoGlyph), func(i int) bool {
+		return mirroredNoGlyph[i] >= r
+	})
+	return i < len(mirroredNoGlyph) && mirroredNoGlyph[i] == r
+}
+
 var controlByteToClass = [16]Class{
 	0xD: LRO, // U+202D LeftToRightOverride,
 	0xE: RLO, // U+202E RightToLeftOverride,
@@ -80,9 +110,12 @@ func LookupRune(r rune) (p Properties, size int) {
 //   - always return 1 byte size for ill-formed UTF-8 runes.
 
 // Lookup returns properties for the first rune in s and the width in bytes of
-// its encoding. The size will be 0 if s does not hold enough bytes to complete
-// the encoding.
+// its encoding. The size will be 0 if s is empty or does not hold enough bytes
+// to complete the encoding.
 func Lookup(s []byte) (p Properties, sz int) {
+	if len(s) == 0 {
+		return Properties{}, 0
+	}
 	c0 := s[0]
 	switch {
 	case c0 < 0x80: // is ASCII
@@ -143,9 +176,12 @@ func Lookup(s []byte) (p Properties, sz int) {
 }
 
 // LookupString returns properties for the first rune in s and the width in
-// bytes of its encoding. The size will be 0 if s does not hold enough bytes to
-// complete the encoding.
+// bytes of its encoding. The size will be 0 if s is empty or does not hold
+// enough bytes to complete the encoding.
 func LookupString(s string) (p Properties, sz int) {
+	if len(s) == 0 {
+		return Properties{}, 0
+	}
 	c0 := s[0]
 	switch {
 	case c0 < 0x80: // is ASCII
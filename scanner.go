@@ -0,0 +1,160 @@
+package sdbidi
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// scanChunk is the initial size of the buffer of a Scanner.
+const scanChunk = 4096
+
+// A Scanner reads a text from an io.Reader and resolves it one paragraph at a
+// time, like a Document does for a text in memory. It holds only the current
+// paragraph and the text read ahead of it, so its memory use is proportional
+// to the length of the longest paragraph. The MaxLength option bounds it for
+// text from untrusted sources.
+//
+// Successive calls of Scan step through the paragraphs. The Paragraph of the
+// Scanner then holds the current one. Its memory is reused for the next
+// paragraph, so that scanning a text does not allocate once the buffers have
+// grown to the size of the longest paragraph.
+type Scanner struct {
+	r  io.Reader
+	o  options
+	sp paragraphSplitter
+	p  Paragraph
+
+	// buf holds the text read from r. The part from pos has not been
+	// scanned yet.
+	buf []byte
+	pos int
+	eof bool
+	err error
+
+	// start, end, runeStart and runeEnd hold the byte and rune position
+	// of the current paragraph.
+	start, end         int64
+	runeStart, runeEnd int64
+}
+
+// NewScanner returns a Scanner that reads from r and resolves the paragraphs
+// with the given options.
+func NewScanner(r io.Reader, opts ...Option) *Scanner {
+	s := &Scanner{r: r, o: newOptions(opts)}
+	s.sp.init(opts, &s.o)
+	return s
+}
+
+// Scan advances to the next paragraph, which is then available through
+// Paragraph. It returns false when it reaches the end of the text or an error,
+// which Err reports. A paragraph separator belongs to the paragraph it
+// terminates.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	if s.o.err != nil {
+		s.err = s.o.err
+		return false
+	}
+	s.start, s.runeStart = s.end, s.runeEnd
+
+	n, err := s.fill()
+	if err != nil {
+		s.err = err
+		return false
+	}
+	if n == 0 {
+		return false
+	}
+
+	_, runes, err := s.sp.next(&s.p, s.buf[s.pos:s.pos+n])
+	if err != nil {
+		s.err = err
+		return false
+	}
+	s.end = s.start + int64(n)
+	s.runeEnd = s.runeStart + int64(runes)
+	s.pos += n
+	return true
+}
+
+// fill reads from r until the unscanned part of buf holds the next paragraph
+// and returns its length, including its separator. It returns 0 at the end of
+// the text.
+func (s *Scanner) fill() (int, error) {
+	scanned := 0
+	for {
+		text := s.buf[s.pos:]
+		end, n := paragraphEnd(text[scanned:], "")
+		end, n = end+scanned, n+scanned
+		// A CR at the end of buf may be followed by an LF that has not
+		// been read yet.
+		if end < len(text) && (n < len(text) || text[end] != '\r') || s.eof {
+			return n, nil
+		}
+		if max := s.o.maxLength; max >= 0 && end/utf8.UTFMax > max {
+			// The paragraph has more than max runes.
+			return 0, &LimitError{Limit: "MaxLength", Max: max}
+		}
+		// The bytes of U+0085 and U+2029 may be split as well, so the
+		// last two bytes are scanned again.
+		if end > 2 {
+			scanned = end - 2
+		}
+		if err := s.read(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// read appends the next bytes read from r to buf, after moving the unscanned
+// part of buf to its start.
+func (s *Scanner) read() error {
+	s.buf = s.buf[:copy(s.buf, s.buf[s.pos:])]
+	s.pos = 0
+	if cap(s.buf) == 0 || 2*len(s.buf) > cap(s.buf) {
+		buf := make([]byte, len(s.buf), 2*cap(s.buf)+scanChunk)
+		copy(buf, s.buf)
+		s.buf = buf
+	}
+	// Like bufio.Scanner, give up on a reader that keeps returning no
+	// data.
+	for i := 0; i < 100; i++ {
+		n, err := s.r.Read(s.buf[len(s.buf):cap(s.buf)])
+		s.buf = s.buf[:len(s.buf)+n]
+		if err == io.EOF {
+			s.eof = true
+			return nil
+		}
+		if n > 0 || err != nil {
+			return err
+		}
+	}
+	return io.ErrNoProgress
+}
+
+// Err returns the first error that was encountered by the Scanner, other than
+// io.EOF.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// Paragraph returns the current paragraph. It is only valid until the next
+// call of Scan.
+func (s *Scanner) Paragraph() *Paragraph {
+	return &s.p
+}
+
+// Pos returns the rune position of the current paragraph within the text read
+// by the Scanner, including its paragraph separator. Like Document.Pos, the
+// end is the position of the last rune.
+func (s *Scanner) Pos() (start, end int64) {
+	return s.runeStart, s.runeEnd - 1
+}
+
+// BytePos returns the half-open range of byte offsets of the current paragraph
+// within the text read by the Scanner, including its paragraph separator.
+func (s *Scanner) BytePos() (start, end int64) {
+	return s.start, s.end
+}
@@ -0,0 +1,196 @@
+package sdbidi
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var ucdClasses = map[string]Class{
+	"L": L, "R": R, "EN": EN, "ES": ES, "ET": ET, "AN": AN, "CS": CS,
+	"B": B, "S": S, "WS": WS, "ON": ON, "BN": BN, "NSM": NSM, "AL": AL,
+	"LRO": LRO, "RLO": RLO, "LRE": LRE, "RLE": RLE, "PDF": PDF,
+	"LRI": LRI, "RLI": RLI, "FSI": FSI, "PDI": PDI,
+
+	"Left_To_Right": L, "Right_To_Left": R, "European_Number": EN,
+	"European_Separator": ES, "European_Terminator": ET, "Arabic_Number": AN,
+	"Common_Separator": CS, "Paragraph_Separator": B, "Segment_Separator": S,
+	"White_Space": WS, "Other_Neutral": ON, "Boundary_Neutral": BN,
+	"Nonspacing_Mark": NSM, "Arabic_Letter": AL,
+}
+
+// parseUCDFile calls fn for each data line of a UCD file. Lines starting with
+// "# @missing:" are passed as data lines as well.
+func parseUCDFile(t *testing.T, path string, fn func(lo, hi rune, fields []string)) {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		text := strings.TrimPrefix(s.Text(), "# @missing:")
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		fields := strings.Split(text, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		lohi := strings.Split(fields[0], "..")
+		lo, err := strconv.ParseUint(lohi[0], 16, 32)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		hi := lo
+		if len(lohi) == 2 {
+			if hi, err = strconv.ParseUint(lohi[1], 16, 32); err != nil {
+				t.Fatalf("%s: %v", path, err)
+			}
+		}
+		fn(rune(lo), rune(hi), fields)
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func parseCodePoint(t *testing.T, s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rune(r)
+}
+
+func TestUnicodeVersion(t *testing.T) {
+	// The brackets U+2E55 LEFT SQUARE BRACKET WITH STROKE to U+2E5C SMALL
+	// RIGHT SQUARE BRACKET WITH DOUBLE STROKE were added in Unicode 14.0.
+	added := UnicodeVersion != "12.0.0"
+	for r := rune(0x2E55); r <= 0x2E5C; r++ {
+		p, _ := LookupRune(r)
+		_, mirror := MirrorGlyph(r)
+		if p.IsBracket() != added || mirror != added || IsMirrored(r) != added {
+			t.Errorf("Unicode %s: %U should be a mirrored bracket: %t", UnicodeVersion, r, added)
+		}
+	}
+}
+
+// TestTables checks the generated tables against the UCD files the generator
+// reads. The test is skipped if testdata holds no files for UnicodeVersion;
+// otherwise each file present is checked.
+func TestTables(t *testing.T) {
+	dir := filepath.Join("testdata", "ucd", UnicodeVersion)
+	if _, err := os.Stat(dir); err != nil {
+		t.Skipf("UCD files for Unicode %s not found: %v", UnicodeVersion, err)
+	}
+	ucdFile := func(t *testing.T, name string) string {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err != nil {
+			t.Skipf("%s of Unicode %s not found: %v", name, UnicodeVersion, err)
+		}
+		return path
+	}
+
+	t.Run("DerivedBidiClass", func(t *testing.T) {
+		classes := make([]Class, 0x110000)
+		parseUCDFile(t, ucdFile(t, "DerivedBidiClass.txt"), func(lo, hi rune, fields []string) {
+			c, ok := ucdClasses[fields[1]]
+			if !ok {
+				t.Fatalf("unknown Bidi class %q", fields[1])
+			}
+			for r := lo; r <= hi; r++ {
+				classes[r] = c
+			}
+		})
+		for r, c := range classes {
+			if r >= 0xD800 && r <= 0xDFFF {
+				continue
+			}
+			if p, _ := LookupRune(rune(r)); p.Class() != c {
+				t.Errorf("%U: class is %d, want %d", r, p.Class(), c)
+			}
+		}
+	})
+
+	t.Run("BidiBrackets", func(t *testing.T) {
+		brackets := map[rune]bool{}
+		parseUCDFile(t, ucdFile(t, "BidiBrackets.txt"), func(r, _ rune, fields []string) {
+			brackets[r] = true
+			p, _ := LookupRune(r)
+			if !p.IsBracket() {
+				t.Errorf("%U: IsBracket should return true", r)
+				return
+			}
+			if open := fields[2] == "o"; p.IsOpeningBracket() != open {
+				t.Errorf("%U: IsOpeningBracket should return %t", r, open)
+			}
+			if pair := parseCodePoint(t, fields[1]); p.reverseBracket(r) != pair {
+				t.Errorf("%U: paired bracket is %U, want %U", r, p.reverseBracket(r), pair)
+			}
+		})
+		for r := rune(0); r < 0x110000; r++ {
+			if p, _ := LookupRune(r); p.IsBracket() && !brackets[r] && (r < 0xD800 || r > 0xDFFF) {
+				t.Errorf("%U: IsBracket should return false", r)
+			}
+		}
+	})
+
+	t.Run("BidiMirroring", func(t *testing.T) {
+		glyphs := map[rune]rune{}
+		parseUCDFile(t, ucdFile(t, "BidiMirroring.txt"), func(r, _ rune, fields []string) {
+			if fields[1] == "<none>" {
+				return // the @missing line
+			}
+			glyphs[r] = parseCodePoint(t, fields[1])
+		})
+		for r := rune(0); r < 0x110000; r++ {
+			want, ok := glyphs[r]
+			if !ok {
+				want = r
+			}
+			if m, mok := MirrorGlyph(r); m != want || mok != ok {
+				t.Errorf("%U: MirrorGlyph returns %U, %t, want %U, %t", r, m, mok, want, ok)
+			}
+		}
+	})
+
+	t.Run("UnicodeData", func(t *testing.T) {
+		// The classes of the code points listed in UnicodeData.txt are
+		// checked as well, since they must agree with DerivedBidiClass.txt.
+		var first rune
+		parseUCDFile(t, ucdFile(t, "UnicodeData.txt"), func(r, _ rune, fields []string) {
+			lo := r
+			switch {
+			case strings.HasSuffix(fields[1], ", First>"):
+				first = r
+				return
+			case strings.HasSuffix(fields[1], ", Last>"):
+				lo = first
+			}
+			c, ok := ucdClasses[fields[4]]
+			if !ok {
+				t.Fatalf("unknown Bidi class %q", fields[4])
+			}
+			mirrored := fields[9] == "Y"
+			for ; lo <= r; lo++ {
+				if lo >= 0xD800 && lo <= 0xDFFF {
+					continue
+				}
+				if p, _ := LookupRune(lo); p.Class() != c {
+					t.Errorf("%U: class is %d, want %d", lo, p.Class(), c)
+				}
+				if IsMirrored(lo) != mirrored {
+					t.Errorf("%U: IsMirrored should return %t", lo, mirrored)
+				}
+			}
+		})
+	})
+}